@@ -0,0 +1,24 @@
+module github.com/volcengine/vikingdb-go-sdk/contrib/otelvikingdb
+
+go 1.25.0
+
+require (
+	github.com/volcengine/vikingdb-go-sdk v0.0.0
+	go.opentelemetry.io/otel v1.46.0
+	go.opentelemetry.io/otel/metric v1.46.0
+	go.opentelemetry.io/otel/trace v1.46.0
+)
+
+require (
+	github.com/cenkalti/backoff/v4 v4.1.2 // indirect
+	github.com/cespare/xxhash/v2 v2.3.0 // indirect
+	github.com/go-logr/logr v1.4.4 // indirect
+	github.com/go-logr/stdr v1.2.2 // indirect
+	github.com/google/uuid v1.3.0 // indirect
+	github.com/volcengine/volc-sdk-golang v1.0.215 // indirect
+	go.opentelemetry.io/auto/sdk v1.2.1 // indirect
+	golang.org/x/net v0.12.0 // indirect
+	golang.org/x/text v0.11.0 // indirect
+)
+
+replace github.com/volcengine/vikingdb-go-sdk => ../../
@@ -0,0 +1,263 @@
+// Copyright (c) 2025 Beijing Volcano Engine Technology Co., Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package otelvikingdb instruments the VikingDB vector client with OpenTelemetry tracing and metrics.
+//
+// Every logical call produces a client span with one child span per retry attempt, so time spent in
+// backoff between attempts is visible in traces:
+//
+//	client, err := vector.New(auth, otelvikingdb.Instrument())
+package otelvikingdb
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/volcengine/vikingdb-go-sdk/vector"
+	"github.com/volcengine/vikingdb-go-sdk/vector/model"
+)
+
+const instrumentationName = "github.com/volcengine/vikingdb-go-sdk/contrib/otelvikingdb"
+
+// Attribute keys recorded on spans and metrics. Metrics only carry the operation, collection, index,
+// HTTP status and error code, so the number of series stays bounded; per-request values such as the
+// request ID are recorded on spans only.
+const (
+	AttrOperation   = attribute.Key("vikingdb.operation")
+	AttrCollection  = attribute.Key("vikingdb.collection")
+	AttrIndex       = attribute.Key("vikingdb.index")
+	AttrProject     = attribute.Key("vikingdb.project")
+	AttrResourceID  = attribute.Key("vikingdb.resource_id")
+	AttrAttempt     = attribute.Key("vikingdb.attempt")
+	AttrErrorCode   = attribute.Key("vikingdb.error_code")
+	AttrRequestID   = attribute.Key("vikingdb.request_id")
+	AttrPath        = attribute.Key("url.path")
+	AttrHTTPMethod  = attribute.Key("http.request.method")
+	AttrHTTPStatus  = attribute.Key("http.response.status_code")
+	attrDBSystem    = attribute.Key("db.system")
+	dbSystemValue   = "vikingdb"
+	attemptSpanName = "vikingdb.attempt"
+)
+
+// Option configures the instrumentation.
+type Option func(*config)
+
+type config struct {
+	tracerProvider trace.TracerProvider
+	meterProvider  metric.MeterProvider
+	propagator     propagation.TextMapPropagator
+}
+
+// WithTracerProvider overrides the global tracer provider.
+func WithTracerProvider(provider trace.TracerProvider) Option {
+	return func(c *config) {
+		c.tracerProvider = provider
+	}
+}
+
+// WithMeterProvider overrides the global meter provider.
+func WithMeterProvider(provider metric.MeterProvider) Option {
+	return func(c *config) {
+		c.meterProvider = provider
+	}
+}
+
+// WithPropagator overrides the global propagator used to inject trace context into request headers.
+func WithPropagator(propagator propagation.TextMapPropagator) Option {
+	return func(c *config) {
+		c.propagator = propagator
+	}
+}
+
+type instrumentation struct {
+	tracer          trace.Tracer
+	propagator      propagation.TextMapPropagator
+	callDuration    metric.Float64Histogram
+	attemptDuration metric.Float64Histogram
+	errors          metric.Int64Counter
+	retries         metric.Int64Counter
+}
+
+// Instrument returns a ClientOption that installs tracing and metrics interceptors on the client.
+func Instrument(opts ...Option) vector.ClientOption {
+	cfg := &config{}
+	for _, opt := range opts {
+		opt(cfg)
+	}
+	if cfg.tracerProvider == nil {
+		cfg.tracerProvider = otel.GetTracerProvider()
+	}
+	if cfg.meterProvider == nil {
+		cfg.meterProvider = otel.GetMeterProvider()
+	}
+	if cfg.propagator == nil {
+		cfg.propagator = otel.GetTextMapPropagator()
+	}
+
+	meter := cfg.meterProvider.Meter(instrumentationName)
+	inst := &instrumentation{
+		tracer:     cfg.tracerProvider.Tracer(instrumentationName),
+		propagator: cfg.propagator,
+	}
+	var err error
+	if inst.callDuration, err = meter.Float64Histogram("vikingdb.client.call.duration",
+		metric.WithUnit("s"), metric.WithDescription("Duration of logical VikingDB calls, including retries.")); err != nil {
+		otel.Handle(err)
+	}
+	if inst.attemptDuration, err = meter.Float64Histogram("vikingdb.client.attempt.duration",
+		metric.WithUnit("s"), metric.WithDescription("Duration of individual HTTP attempts.")); err != nil {
+		otel.Handle(err)
+	}
+	if inst.errors, err = meter.Int64Counter("vikingdb.client.errors",
+		metric.WithDescription("Logical VikingDB calls that returned an error.")); err != nil {
+		otel.Handle(err)
+	}
+	if inst.retries, err = meter.Int64Counter("vikingdb.client.retries",
+		metric.WithDescription("HTTP attempts beyond the first one.")); err != nil {
+		otel.Handle(err)
+	}
+
+	return func(c *vector.Config) {
+		vector.WithCallInterceptors(inst.interceptCall)(c)
+		vector.WithInterceptors(inst.interceptAttempt)(c)
+	}
+}
+
+func (i *instrumentation) interceptCall(ctx context.Context, info *vector.CallInfo, next vector.CallInvoker) error {
+	attrs := callAttributes(info)
+	ctx, span := i.tracer.Start(ctx, "vikingdb "+info.Operation,
+		trace.WithSpanKind(trace.SpanKindClient), trace.WithAttributes(attrs...))
+	defer span.End()
+
+	start := time.Now()
+	err := next(ctx, info)
+	elapsed := time.Since(start).Seconds()
+
+	resultAttrs := resultAttributes(info, err)
+	span.SetAttributes(resultAttrs...)
+	span.SetAttributes(requestIDAttributes(info, err)...)
+	recordError(span, err)
+
+	metricAttrs := metric.WithAttributes(append(metricAttributes(info), resultAttrs...)...)
+	if i.callDuration != nil {
+		i.callDuration.Record(ctx, elapsed, metricAttrs)
+	}
+	if err != nil && i.errors != nil {
+		i.errors.Add(ctx, 1, metricAttrs)
+	}
+	return err
+}
+
+func (i *instrumentation) interceptAttempt(ctx context.Context, info *vector.CallInfo, req *http.Request, next vector.Invoker) error {
+	ctx, span := i.tracer.Start(ctx, attemptSpanName,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(AttrOperation.String(info.Operation), AttrAttempt.Int(info.Attempt)))
+	defer span.End()
+
+	i.propagator.Inject(ctx, propagation.HeaderCarrier(req.Header))
+	req = req.WithContext(ctx)
+
+	start := time.Now()
+	err := next(ctx, info, req)
+	elapsed := time.Since(start).Seconds()
+
+	resultAttrs := resultAttributes(info, err)
+	span.SetAttributes(resultAttrs...)
+	span.SetAttributes(requestIDAttributes(info, err)...)
+	recordError(span, err)
+
+	attrs := metric.WithAttributes(append(metricAttributes(info), resultAttrs...)...)
+	if i.attemptDuration != nil {
+		i.attemptDuration.Record(ctx, elapsed, attrs)
+	}
+	if info.Attempt > 0 && i.retries != nil {
+		i.retries.Add(ctx, 1, metric.WithAttributes(metricAttributes(info)...))
+	}
+	return err
+}
+
+func callAttributes(info *vector.CallInfo) []attribute.KeyValue {
+	attrs := []attribute.KeyValue{
+		attrDBSystem.String(dbSystemValue),
+		AttrOperation.String(info.Operation),
+		AttrPath.String(info.Path),
+		AttrHTTPMethod.String(info.Method),
+	}
+	if info.Collection.CollectionName != "" {
+		attrs = append(attrs, AttrCollection.String(info.Collection.CollectionName))
+	}
+	if info.Collection.ProjectName != "" {
+		attrs = append(attrs, AttrProject.String(info.Collection.ProjectName))
+	}
+	if info.Collection.ResourceID != "" {
+		attrs = append(attrs, AttrResourceID.String(info.Collection.ResourceID))
+	}
+	if info.IndexName != "" {
+		attrs = append(attrs, AttrIndex.String(info.IndexName))
+	}
+	return attrs
+}
+
+// metricAttributes identifies the target of a call with the low-cardinality attributes shared by spans
+// and metrics.
+func metricAttributes(info *vector.CallInfo) []attribute.KeyValue {
+	attrs := []attribute.KeyValue{AttrOperation.String(info.Operation)}
+	if info.Collection.CollectionName != "" {
+		attrs = append(attrs, AttrCollection.String(info.Collection.CollectionName))
+	}
+	if info.IndexName != "" {
+		attrs = append(attrs, AttrIndex.String(info.IndexName))
+	}
+	return attrs
+}
+
+// resultAttributes describes the outcome of a call or attempt with its HTTP status and error code.
+func resultAttributes(info *vector.CallInfo, err error) []attribute.KeyValue {
+	var attrs []attribute.KeyValue
+	status := 0
+	if info.HTTPResponse != nil {
+		status = info.HTTPResponse.StatusCode
+	}
+	var sdkErr *model.Error
+	if errors.As(err, &sdkErr) {
+		attrs = append(attrs, AttrErrorCode.String(string(sdkErr.Code)))
+		if status == 0 {
+			status = sdkErr.StatusCode
+		}
+	}
+	if status != 0 {
+		attrs = append(attrs, AttrHTTPStatus.Int(status))
+	}
+	return attrs
+}
+
+// requestIDAttributes returns the request ID of a call. It is unique per request, so it belongs on spans
+// and never on metrics.
+func requestIDAttributes(info *vector.CallInfo, err error) []attribute.KeyValue {
+	requestID := info.RequestID
+	var sdkErr *model.Error
+	if requestID == "" && errors.As(err, &sdkErr) {
+		requestID = sdkErr.RequestID
+	}
+	if requestID == "" {
+		return nil
+	}
+	return []attribute.KeyValue{AttrRequestID.String(requestID)}
+}
+
+func recordError(span trace.Span, err error) {
+	if err == nil {
+		return
+	}
+	span.RecordError(err)
+	span.SetStatus(codes.Error, err.Error())
+}
@@ -0,0 +1,170 @@
+// Copyright (c) 2025 Beijing Volcano Engine Technology Co., Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package otelvikingdb
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	metricnoop "go.opentelemetry.io/otel/metric/noop"
+	"go.opentelemetry.io/otel/trace"
+	tracenoop "go.opentelemetry.io/otel/trace/noop"
+
+	"github.com/volcengine/vikingdb-go-sdk/vector/model"
+	"github.com/volcengine/vikingdb-go-sdk/vector/vectortest"
+)
+
+// recorder collects the attribute sets recorded on metrics and spans.
+type recorder struct {
+	mu      sync.Mutex
+	metrics map[string][]attribute.Set
+	spans   []attribute.Set
+}
+
+func (r *recorder) addMetric(name string, set attribute.Set) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.metrics == nil {
+		r.metrics = make(map[string][]attribute.Set)
+	}
+	r.metrics[name] = append(r.metrics[name], set)
+}
+
+type meterProvider struct {
+	metricnoop.MeterProvider
+	rec *recorder
+}
+
+func (p meterProvider) Meter(string, ...metric.MeterOption) metric.Meter {
+	return meter{rec: p.rec}
+}
+
+type meter struct {
+	metricnoop.Meter
+	rec *recorder
+}
+
+func (m meter) Float64Histogram(name string, _ ...metric.Float64HistogramOption) (metric.Float64Histogram, error) {
+	return histogram{name: name, rec: m.rec}, nil
+}
+
+func (m meter) Int64Counter(name string, _ ...metric.Int64CounterOption) (metric.Int64Counter, error) {
+	return counter{name: name, rec: m.rec}, nil
+}
+
+type histogram struct {
+	metricnoop.Float64Histogram
+	name string
+	rec  *recorder
+}
+
+func (h histogram) Record(_ context.Context, _ float64, opts ...metric.RecordOption) {
+	h.rec.addMetric(h.name, metric.NewRecordConfig(opts).Attributes())
+}
+
+type counter struct {
+	metricnoop.Int64Counter
+	name string
+	rec  *recorder
+}
+
+func (c counter) Add(_ context.Context, _ int64, opts ...metric.AddOption) {
+	c.rec.addMetric(c.name, metric.NewAddConfig(opts).Attributes())
+}
+
+type tracerProvider struct {
+	tracenoop.TracerProvider
+	rec *recorder
+}
+
+func (p tracerProvider) Tracer(string, ...trace.TracerOption) trace.Tracer {
+	return tracer{rec: p.rec}
+}
+
+type tracer struct {
+	tracenoop.Tracer
+	rec *recorder
+}
+
+func (t tracer) Start(ctx context.Context, _ string, opts ...trace.SpanStartOption) (context.Context, trace.Span) {
+	config := trace.NewSpanStartConfig(opts...)
+	s := &span{rec: t.rec, attrs: config.Attributes()}
+	return trace.ContextWithSpan(ctx, s), s
+}
+
+type span struct {
+	tracenoop.Span
+	rec   *recorder
+	attrs []attribute.KeyValue
+}
+
+func (s *span) SetAttributes(kv ...attribute.KeyValue) {
+	s.attrs = append(s.attrs, kv...)
+}
+
+func (s *span) End(...trace.SpanEndOption) {
+	s.rec.mu.Lock()
+	s.rec.spans = append(s.rec.spans, attribute.NewSet(s.attrs...))
+	s.rec.mu.Unlock()
+}
+
+func TestMetricsExcludeRequestID(t *testing.T) {
+	srv := vectortest.NewServer()
+	defer srv.Close()
+	rec := &recorder{}
+	client, err := srv.NewClient(Instrument(WithMeterProvider(meterProvider{rec: rec}), WithTracerProvider(tracerProvider{rec: rec})))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	collection := client.Collection(model.CollectionLocator{CollectionName: "docs"})
+	for i := 0; i < 3; i++ {
+		if _, err := collection.Upsert(context.Background(), model.UpsertDataRequest{
+			WriteDataBase: model.WriteDataBase{Data: []model.MapStr{{"id": i}}},
+		}); err != nil {
+			t.Fatalf("Upsert: %v", err)
+		}
+	}
+	// The fake rejects a scalar search without a field, which exercises the error counter.
+	index := client.Index(model.IndexLocator{CollectionLocator: model.CollectionLocator{CollectionName: "docs"}, IndexName: "idx"})
+	if _, err := index.SearchByScalar(context.Background(), model.SearchByScalarRequest{}); err == nil {
+		t.Fatal("expected the search to fail")
+	}
+
+	allowed := map[attribute.Key]bool{
+		AttrOperation: true, AttrCollection: true, AttrIndex: true, AttrHTTPStatus: true, AttrErrorCode: true,
+	}
+	for _, name := range []string{"vikingdb.client.call.duration", "vikingdb.client.attempt.duration", "vikingdb.client.errors"} {
+		sets := rec.metrics[name]
+		if len(sets) == 0 {
+			t.Fatalf("no %s recorded", name)
+		}
+		distinct := map[attribute.Distinct]bool{}
+		for _, set := range sets {
+			for _, kv := range set.ToSlice() {
+				if !allowed[kv.Key] {
+					t.Fatalf("%s carries attribute %s", name, kv.Key)
+				}
+			}
+			distinct[set.Equivalent()] = true
+		}
+		// Three successful upserts and one failed search make at most two series per instrument.
+		if len(distinct) > 2 {
+			t.Fatalf("%s produced %d series", name, len(distinct))
+		}
+	}
+
+	withRequestID := 0
+	for _, set := range rec.spans {
+		if value, ok := set.Value(AttrRequestID); ok && value.AsString() != "" {
+			withRequestID++
+		}
+	}
+	if withRequestID == 0 {
+		t.Fatal("no span carries the request ID")
+	}
+}
@@ -36,8 +36,8 @@ func main() {
 		ctx,
 		"session_001",
 		[]memory.Message{
-			{Role: "user", Content: "今天天气怎么样？"},
-			{Role: "assistant", Content: "今天天气晴朗，气温22度，非常适合外出。"},
+			{Role: memory.RoleUser, Content: "今天天气怎么样？"},
+			{Role: memory.RoleAssistant, Content: "今天天气晴朗，气温22度，非常适合外出。"},
 		},
 		memory.WithMetadata(map[string]interface{}{
 			"default_user_id":      "user_01",
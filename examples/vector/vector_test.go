@@ -13,6 +13,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/volcengine/vikingdb-go-sdk/vector"
+	"github.com/volcengine/vikingdb-go-sdk/vector/filter"
 	"github.com/volcengine/vikingdb-go-sdk/vector/model"
 )
 
@@ -166,10 +167,10 @@ func TestScenarioIndexSearchMultiModal(t *testing.T) {
 
 	time.Sleep(3 * time.Second)
 
-	filter := andFilters(
+	sessionFilter := filter.And(
 		sessionParagraphBounds(baseParagraph, len(chapters)),
 		scoreAtLeastFilter(85.0),
-	)
+	).MustBuild()
 
 	query := findChapter(t, chapters, "retrieval-lab").Text
 	limit := 3
@@ -177,7 +178,7 @@ func TestScenarioIndexSearchMultiModal(t *testing.T) {
 		Text: &query,
 		SearchBase: model.SearchBase{
 			RecallBase: model.RecallBase{
-				Filter: filter,
+				Filter: sessionFilter,
 			},
 			Limit:        &limit,
 			OutputFields: []string{"title", "score", "paragraph"},
@@ -267,12 +268,12 @@ func TestScenarioIndexSearchVector(t *testing.T) {
 	queryVector := embedSingleText(t, ctx, embeddingClient, targetChapter.Text, modelName, modelVersion)
 
 	// 4. Run SearchByVector over the chapter window we just created.
-	filter := sessionParagraphBounds(baseParagraph, len(chapters))
+	sessionFilter := sessionParagraphBounds(baseParagraph, len(chapters)).MustBuild()
 	limit := 5
 	searchReq := model.SearchByVectorRequest{
 		SearchBase: model.SearchBase{
 			RecallBase: model.RecallBase{
-				Filter: filter,
+				Filter: sessionFilter,
 			},
 			Limit:        &limit,
 			OutputFields: []string{"title", "score", "paragraph"},
@@ -332,7 +333,7 @@ func TestScenarioSearchKeywords(t *testing.T) {
 	time.Sleep(5 * time.Second)
 
 	limit := 3
-	sessionFilter := sessionParagraphBounds(baseParagraph, len(chapters))
+	sessionFilter := sessionParagraphBounds(baseParagraph, len(chapters)).MustBuild()
 
 	keywordsReq := model.SearchByKeywordsRequest{
 		Keywords: []string{"Signal"},
@@ -761,51 +762,12 @@ func findChapter(t *testing.T, chapters []*storyChapter, key string) *storyChapt
 }
 
 // sessionParagraphBounds returns a simple paragraph range filter to scope the session's documents.
-func sessionParagraphBounds(base int64, count int) model.MapStr {
-	return model.MapStr{
-		"op":    "range",
-		"field": "paragraph",
-		"gte":   base,
-		"lt":    base + int64(count),
-	}
+func sessionParagraphBounds(base int64, count int) filter.Filter {
+	return filter.Range("paragraph", filter.Gte(base), filter.Lt(base+int64(count)))
 }
 
-func mustFilter(field string, conds ...interface{}) model.MapStr {
-	return model.MapStr{
-		"op":    "must",
-		"field": field,
-		"conds": conds,
-	}
-}
-
-func scoreAtLeastFilter(min float64) model.MapStr {
-	return model.MapStr{
-		"op":    "range",
-		"field": "score",
-		"gt":    min,
-	}
-}
-
-func andFilters(conds ...model.MapStr) model.MapStr {
-	filtered := make([]model.MapStr, 0, len(conds))
-	for _, cond := range conds {
-		if cond == nil || len(cond) == 0 {
-			continue
-		}
-		filtered = append(filtered, cond)
-	}
-
-	switch len(filtered) {
-	case 0:
-		return nil
-	case 1:
-		return filtered[0]
-	default:
-		return model.MapStr{
-			"op":    "and",
-			"conds": filtered,
-		}
-	}
+func scoreAtLeastFilter(min float64) filter.Filter {
+	return filter.Range("score", filter.Gt(min))
 }
 
 // float32SliceToFloat64 converts embedding vectors to the dtype expected by SearchByVector.
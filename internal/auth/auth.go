@@ -0,0 +1,150 @@
+// Copyright (c) 2025 Beijing Volcano Engine Technology Co., Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package auth implements the request authentication shared by the vector and memory clients.
+package auth
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/volcengine/volc-sdk-golang/base"
+
+	"github.com/volcengine/vikingdb-go-sdk/vector/credentials"
+	"github.com/volcengine/vikingdb-go-sdk/vector/model"
+)
+
+// Kind identifies the authentication scheme of an Auth.
+type Kind int
+
+const (
+	KindNone Kind = iota
+	KindIAM
+	KindAPIKey
+)
+
+// Auth describes how outgoing requests are authenticated. The zero value disables authentication.
+type Auth struct {
+	kind      Kind
+	accessKey string
+	secretKey string
+	apiKey    string
+	provider  credentials.Provider
+}
+
+// None disables request signing.
+func None() Auth {
+	return Auth{kind: KindNone}
+}
+
+// IAM configures AK/SK signing with static keys.
+func IAM(accessKey, secretKey string) Auth {
+	return Auth{kind: KindIAM, accessKey: accessKey, secretKey: secretKey}
+}
+
+// IAMWithProvider configures AK/SK signing with keys fetched from provider on every request.
+func IAMWithProvider(provider credentials.Provider) Auth {
+	return Auth{kind: KindIAM, provider: provider}
+}
+
+// APIKey configures bearer API key authentication with a static key.
+func APIKey(apiKey string) Auth {
+	return Auth{kind: KindAPIKey, apiKey: apiKey}
+}
+
+// APIKeyWithProvider configures bearer API key authentication with the key fetched from provider on every request.
+func APIKeyWithProvider(provider credentials.Provider) Auth {
+	return Auth{kind: KindAPIKey, provider: provider}
+}
+
+// Kind reports the configured scheme.
+func (a Auth) Kind() Kind {
+	return a.kind
+}
+
+// Authenticator decorates outgoing requests with credentials.
+type Authenticator interface {
+	Apply(req *http.Request, region string) (*http.Request, error)
+}
+
+// NewAuthenticator validates a and returns the matching Authenticator. IAM requests are signed for service.
+func NewAuthenticator(a Auth, service string) (Authenticator, error) {
+	switch a.kind {
+	case KindIAM:
+		provider := a.provider
+		if provider == nil {
+			if a.accessKey == "" || a.secretKey == "" {
+				return nil, model.NewInvalidParameterError("access key and secret key cannot be empty")
+			}
+			provider = credentials.NewStaticProvider(a.accessKey, a.secretKey)
+		}
+		return iamAuth{provider: provider, service: service}, nil
+	case KindAPIKey:
+		provider := a.provider
+		if provider == nil {
+			if a.apiKey == "" {
+				return nil, model.NewInvalidParameterError("api key cannot be empty")
+			}
+			provider = credentials.NewStaticAPIKeyProvider(a.apiKey)
+		}
+		return apiKeyAuth{provider: provider}, nil
+	default:
+		return noAuth{}, nil
+	}
+}
+
+type noAuth struct{}
+
+func (noAuth) Apply(req *http.Request, _ string) (*http.Request, error) {
+	return req, nil
+}
+
+type apiKeyAuth struct {
+	provider credentials.Provider
+}
+
+func (a apiKeyAuth) Apply(req *http.Request, _ string) (*http.Request, error) {
+	value, err := retrieve(req.Context(), a.provider)
+	if err != nil {
+		return nil, err
+	}
+	if value.APIKey == "" {
+		return nil, model.NewInvalidParameterError("api key cannot be empty")
+	}
+	req.Header.Set("Authorization", "Bearer "+value.APIKey)
+	return req, nil
+}
+
+type iamAuth struct {
+	provider credentials.Provider
+	service  string
+}
+
+func (a iamAuth) Apply(req *http.Request, region string) (*http.Request, error) {
+	value, err := retrieve(req.Context(), a.provider)
+	if err != nil {
+		return nil, err
+	}
+	if !value.HasKeys() {
+		return nil, model.NewInvalidParameterError("access key and secret key cannot be empty")
+	}
+	credential := base.Credentials{
+		AccessKeyID:     value.AccessKey,
+		SecretAccessKey: value.SecretKey,
+		SessionToken:    value.SessionToken,
+		Service:         a.service,
+		Region:          region,
+	}
+	return credential.Sign(req), nil
+}
+
+func retrieve(ctx context.Context, provider credentials.Provider) (credentials.Value, error) {
+	value, err := provider.Retrieve(ctx)
+	if err != nil {
+		if _, ok := err.(*model.Error); ok {
+			return credentials.Value{}, err
+		}
+		return credentials.Value{}, model.NewErrorWithCause(model.ErrCodeUnauthorized, "failed to retrieve credentials", err, http.StatusUnauthorized)
+	}
+	return value, nil
+}
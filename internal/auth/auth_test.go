@@ -0,0 +1,88 @@
+// Copyright (c) 2025 Beijing Volcano Engine Technology Co., Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package auth
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/volcengine/vikingdb-go-sdk/vector/credentials"
+	"github.com/volcengine/vikingdb-go-sdk/vector/model"
+)
+
+type failingProvider struct{ err error }
+
+func (p failingProvider) Retrieve(context.Context) (credentials.Value, error) {
+	return credentials.Value{}, p.err
+}
+
+func TestNewAuthenticatorValidates(t *testing.T) {
+	cases := []struct {
+		name string
+		auth Auth
+	}{
+		{name: "IAM without secret key", auth: IAM("ak", "")},
+		{name: "API key without key", auth: APIKey("")},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := NewAuthenticator(tc.auth, "air")
+			var sdkErr *model.Error
+			if !errors.As(err, &sdkErr) || sdkErr.Code != model.ErrCodeInvalidParameter {
+				t.Fatalf("err = %v, want an InvalidParameter error", err)
+			}
+		})
+	}
+}
+
+func TestApply(t *testing.T) {
+	cases := []struct {
+		name     string
+		auth     Auth
+		service  string
+		region   string
+		wantAuth string
+		wantDate bool
+		wantCode model.ErrorCode
+	}{
+		{name: "none", auth: None()},
+		{name: "API key", auth: APIKey("secret"), wantAuth: "Bearer secret"},
+		{name: "IAM", auth: IAM("AKTEST", "SKTEST"), service: "air", region: "cn-beijing", wantAuth: "/cn-beijing/air/request", wantDate: true},
+		{name: "IAM for another region", auth: IAM("AKTEST", "SKTEST"), service: "vikingdb", region: "cn-shanghai", wantAuth: "/cn-shanghai/vikingdb/request", wantDate: true},
+		{name: "provider failure", auth: IAMWithProvider(failingProvider{err: errors.New("no keys")}), wantCode: model.ErrCodeUnauthorized},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			authenticator, err := NewAuthenticator(tc.auth, tc.service)
+			if err != nil {
+				t.Fatal(err)
+			}
+			req, _ := http.NewRequest(http.MethodPost, "http://example.com/api/memory/search", strings.NewReader(`{}`))
+			signed, err := authenticator.Apply(req, tc.region)
+			if tc.wantCode != "" {
+				var sdkErr *model.Error
+				if !errors.As(err, &sdkErr) || sdkErr.Code != tc.wantCode {
+					t.Fatalf("err = %v, want code %s", err, tc.wantCode)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			header := signed.Header.Get("Authorization")
+			if tc.wantAuth == "" && header != "" {
+				t.Fatalf("Authorization = %q, want none", header)
+			}
+			if !strings.Contains(header, tc.wantAuth) {
+				t.Fatalf("Authorization = %q, want it to contain %q", header, tc.wantAuth)
+			}
+			if got := signed.Header.Get("X-Date") != ""; got != tc.wantDate {
+				t.Fatalf("X-Date set = %v, want %v", got, tc.wantDate)
+			}
+		})
+	}
+}
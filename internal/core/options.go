@@ -0,0 +1,69 @@
+// Copyright (c) 2025 Beijing Volcano Engine Technology Co., Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package core
+
+import "github.com/volcengine/vikingdb-go-sdk/vector/utils"
+
+// RequestOptions captures per-request overrides for retries, headers, and query params.
+type RequestOptions struct {
+	MaxRetries  int
+	RetryPolicy *utils.RetryPolicy
+	Headers     map[string]string
+	Query       map[string]string
+	RequestID   string
+}
+
+// RequestOption mutates RequestOptions when constructing a request.
+type RequestOption func(*RequestOptions)
+
+// NewRequestOptions builds a RequestOptions instance with empty header and query maps and applies opts.
+func NewRequestOptions(opts ...RequestOption) *RequestOptions {
+	o := &RequestOptions{
+		Headers: make(map[string]string),
+		Query:   make(map[string]string),
+	}
+	for _, opt := range opts {
+		opt(o)
+	}
+	return o
+}
+
+// WithMaxRetries limits the retry count for the current request.
+func WithMaxRetries(maxRetries int) RequestOption {
+	return func(o *RequestOptions) {
+		o.MaxRetries = maxRetries
+	}
+}
+
+// WithRetryPolicy overrides the client retry policy for the current request.
+func WithRetryPolicy(policy utils.RetryPolicy) RequestOption {
+	return func(o *RequestOptions) {
+		o.RetryPolicy = &policy
+	}
+}
+
+// WithHeaders merges the provided headers into the request.
+func WithHeaders(headers map[string]string) RequestOption {
+	return func(o *RequestOptions) {
+		for k, v := range headers {
+			o.Headers[k] = v
+		}
+	}
+}
+
+// WithQueryParams merges the provided query parameters into the request.
+func WithQueryParams(params map[string]string) RequestOption {
+	return func(o *RequestOptions) {
+		for k, v := range params {
+			o.Query[k] = v
+		}
+	}
+}
+
+// WithRequestID sets the request id which will be propagated as X-Tt-Logid.
+func WithRequestID(requestID string) RequestOption {
+	return func(o *RequestOptions) {
+		o.RequestID = requestID
+	}
+}
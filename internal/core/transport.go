@@ -0,0 +1,161 @@
+// Copyright (c) 2025 Beijing Volcano Engine Technology Co., Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package core implements the HTTP transport shared by the vector and memory clients: request building,
+// signing, retries and decoding of service errors into *model.Error.
+package core
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"net/url"
+
+	"github.com/volcengine/vikingdb-go-sdk/internal/auth"
+	"github.com/volcengine/vikingdb-go-sdk/vector/model"
+	"github.com/volcengine/vikingdb-go-sdk/vector/utils"
+)
+
+// RequestIDHeader carries the request identifier in both directions.
+const RequestIDHeader = "X-Tt-Logid"
+
+// Config holds the settings of a Transport. HTTPClient and Auth must be set.
+type Config struct {
+	HTTPClient  *http.Client
+	Auth        auth.Authenticator
+	UserAgent   string
+	MaxRetries  int
+	RetryPolicy *utils.RetryPolicy
+}
+
+// Transport executes signed JSON requests.
+type Transport struct {
+	config Config
+}
+
+// NewTransport returns a Transport for cfg.
+func NewTransport(cfg Config) *Transport {
+	if cfg.MaxRetries < 0 {
+		cfg.MaxRetries = 0
+	}
+	return &Transport{config: cfg}
+}
+
+// HTTPClient returns the client used to execute requests.
+func (t *Transport) HTTPClient() *http.Client {
+	return t.config.HTTPClient
+}
+
+// Retries returns the retry budget for a request, preferring the per-request override.
+func (t *Transport) Retries(opts *RequestOptions) int {
+	retries := opts.MaxRetries
+	if retries <= 0 {
+		retries = t.config.MaxRetries
+	}
+	if retries < 0 {
+		retries = 0
+	}
+	return retries
+}
+
+// RetryPolicy returns the retry policy for a request, preferring the per-request override.
+func (t *Transport) RetryPolicy(opts *RequestOptions) utils.RetryPolicy {
+	if opts.RetryPolicy != nil {
+		return *opts.RetryPolicy
+	}
+	if t.config.RetryPolicy != nil {
+		return *t.config.RetryPolicy
+	}
+	return utils.DefaultRetryPolicy()
+}
+
+// MarshalBody serializes a request payload, returning nil for a nil request.
+func MarshalBody(request interface{}) ([]byte, error) {
+	if request == nil {
+		return nil, nil
+	}
+	body, err := utils.SerializeToJSON(request)
+	if err != nil {
+		return nil, model.NewErrorWithCause(model.ErrCodeInvalidParameter, "failed to marshal request", err, http.StatusBadRequest)
+	}
+	return body, nil
+}
+
+// BuildRequest creates an unsigned request for path relative to baseURL.
+func (t *Transport) BuildRequest(ctx context.Context, baseURL *url.URL, method, path string, body []byte, opts *RequestOptions) (*http.Request, error) {
+	targetURL := baseURL.ResolveReference(&url.URL{Path: path})
+	if len(opts.Query) > 0 {
+		query := targetURL.Query()
+		for k, v := range opts.Query {
+			query.Set(k, v)
+		}
+		targetURL.RawQuery = query.Encode()
+	}
+
+	var buf io.Reader
+	if len(body) > 0 {
+		buf = bytes.NewReader(body)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, targetURL.String(), buf)
+	if err != nil {
+		return nil, model.NewErrorWithCause(model.ErrCodeUnknown, "failed to create request", err, http.StatusBadRequest)
+	}
+
+	if len(body) > 0 {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	req.Header.Set("Accept", "application/json")
+	if t.config.UserAgent != "" {
+		req.Header.Set("User-Agent", t.config.UserAgent)
+	}
+
+	for k, v := range opts.Headers {
+		req.Header.Set(k, v)
+	}
+	if opts.RequestID != "" {
+		req.Header.Set(RequestIDHeader, opts.RequestID)
+	}
+	return req, nil
+}
+
+// Send signs req for region, executes it and decodes the reply into response. The HTTP response is
+// returned, with its body already consumed, whenever the server answered.
+func (t *Transport) Send(req *http.Request, region string, response interface{}) (*http.Response, error) {
+	signedReq, err := t.config.Auth.Apply(req, region)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := utils.DoHTTPRequest(t.config.HTTPClient, signedReq)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	err = utils.ParseResponse(resp, response)
+	if sdkErr, ok := err.(*model.Error); ok && sdkErr.RequestID == "" {
+		sdkErr.RequestID = resp.Header.Get(RequestIDHeader)
+	}
+	return resp, err
+}
+
+// Do performs a request against a single endpoint with retries.
+func (t *Transport) Do(ctx context.Context, baseURL *url.URL, region, method, path string, request, response interface{}, opts *RequestOptions) error {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	body, err := MarshalBody(request)
+	if err != nil {
+		return err
+	}
+	return utils.RetryWithPolicy(ctx, t.Retries(opts), t.RetryPolicy(opts), func() error {
+		req, err := t.BuildRequest(ctx, baseURL, method, path, body, opts)
+		if err != nil {
+			return err
+		}
+		_, err = t.Send(req, region, response)
+		return err
+	})
+}
@@ -0,0 +1,67 @@
+// Copyright (c) 2025 Beijing Volcano Engine Technology Co., Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package core
+
+import (
+	"context"
+	"errors"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/volcengine/vikingdb-go-sdk/internal/auth"
+	"github.com/volcengine/vikingdb-go-sdk/vector/model"
+)
+
+func TestSendParsesServiceErrors(t *testing.T) {
+	cases := []struct {
+		name          string
+		status        int
+		header        string
+		body          string
+		wantCode      model.ErrorCode
+		wantMessage   string
+		wantRequestID string
+	}{
+		{name: "numeric code", status: http.StatusBadRequest, body: `{"code":1000001,"message":"collection not found","request_id":"req-1"}`, wantCode: "1000001", wantMessage: "collection not found", wantRequestID: "req-1"},
+		{name: "large numeric code keeps its digits", status: http.StatusInternalServerError, body: `{"code":12345678901,"message":"internal"}`, wantCode: "12345678901", wantMessage: "internal"},
+		{name: "string code", status: http.StatusNotFound, body: `{"code":"NotFound","message":"missing"}`, wantCode: model.ErrCodeNotFound, wantMessage: "missing"},
+		{name: "request ID from the header", status: http.StatusBadRequest, header: "req-2", body: `{"code":1000002,"message":"bad"}`, wantCode: "1000002", wantMessage: "bad", wantRequestID: "req-2"},
+		{name: "body without JSON", status: http.StatusBadGateway, body: "upstream down", wantCode: model.ErrCodeUnknown},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_, _ = io.Copy(ioutil.Discard, r.Body)
+				if tc.header != "" {
+					w.Header().Set(RequestIDHeader, tc.header)
+				}
+				w.WriteHeader(tc.status)
+				_, _ = w.Write([]byte(tc.body))
+			}))
+			defer srv.Close()
+			baseURL, _ := url.Parse(srv.URL)
+			authenticator, _ := auth.NewAuthenticator(auth.None(), "")
+			transport := NewTransport(Config{HTTPClient: srv.Client(), Auth: authenticator})
+
+			err := transport.Do(context.Background(), baseURL, "cn-beijing", http.MethodPost, "/api/memory/search", map[string]string{"query": "q"}, &struct{}{}, NewRequestOptions())
+			var sdkErr *model.Error
+			if !errors.As(err, &sdkErr) {
+				t.Fatalf("err = %#v, want *model.Error", err)
+			}
+			if sdkErr.Code != tc.wantCode || sdkErr.StatusCode != tc.status {
+				t.Fatalf("code = %q status = %d, want %q and %d", sdkErr.Code, sdkErr.StatusCode, tc.wantCode, tc.status)
+			}
+			if tc.wantMessage != "" && sdkErr.Message != tc.wantMessage {
+				t.Fatalf("message = %q, want %q", sdkErr.Message, tc.wantMessage)
+			}
+			if sdkErr.RequestID != tc.wantRequestID {
+				t.Fatalf("request ID = %q, want %q", sdkErr.RequestID, tc.wantRequestID)
+			}
+		})
+	}
+}
@@ -4,118 +4,68 @@
 package memory
 
 import (
-	"bytes"
 	"context"
-	"encoding/json"
 	"fmt"
 	"net/http"
 	"net/url"
-	"time"
-)
-
-// Version denotes the SDK version.
-const Version = "0.1.0"
 
-type authKind int
-
-const (
-	authKindNone authKind = iota
-	authKindIAM
-	authKindAPIKey
+	"github.com/volcengine/vikingdb-go-sdk/internal/auth"
+	"github.com/volcengine/vikingdb-go-sdk/internal/core"
+	"github.com/volcengine/vikingdb-go-sdk/vector/credentials"
+	"github.com/volcengine/vikingdb-go-sdk/vector/model"
 )
 
-type Auth struct {
-	kind      authKind
-	accessKey string
-	secretKey string
-	apiKey    string
-}
+// Auth describes how the client authenticates outgoing requests. It is shared with the vector package.
+type Auth = auth.Auth
 
+// AuthNone disables request signing.
 func AuthNone() Auth {
-	return Auth{kind: authKindNone}
+	return auth.None()
 }
 
+// AuthIAM configures AK/SK signing.
 func AuthIAM(accessKey, secretKey string) Auth {
-	return Auth{kind: authKindIAM, accessKey: accessKey, secretKey: secretKey}
+	return auth.IAM(accessKey, secretKey)
 }
 
-func AuthAPIKey(apiKey string) Auth {
-	return Auth{kind: authKindAPIKey, apiKey: apiKey}
+// AuthIAMWithProvider configures AK/SK signing with credentials fetched from provider on every request.
+func AuthIAMWithProvider(provider credentials.Provider) Auth {
+	return auth.IAMWithProvider(provider)
 }
 
-type Config struct {
-	Endpoint   string
-	Region     string
-	Timeout    time.Duration
-	MaxRetries int
-	HTTPClient *http.Client
-	UserAgent  string
+// AuthAPIKey configures API key authentication.
+func AuthAPIKey(apiKey string) Auth {
+	return auth.APIKey(apiKey)
 }
 
-func DefaultConfig() Config {
-	return Config{
-		Endpoint:   "http://api-knowledgebase.mlp.cn-beijing.volces.com",
-		Region:     "cn-beijing",
-		Timeout:    30 * time.Second,
-		MaxRetries: 3,
-	}
+// AuthAPIKeyWithProvider configures API key authentication with the key fetched from provider on every request.
+func AuthAPIKeyWithProvider(provider credentials.Provider) Auth {
+	return auth.APIKeyWithProvider(provider)
 }
 
 type transport struct {
-	config     Config
-	httpClient *http.Client
-	baseURL    *url.URL
-	auth       authenticator
-	userAgent  string
-}
-
-type authenticator interface {
-	apply(req *http.Request) (*http.Request, error)
-}
-
-type noAuth struct{}
-
-func (noAuth) apply(req *http.Request) (*http.Request, error) {
-	return req, nil
-}
-
-type apiKeyAuth struct {
-	token string
-}
-
-func (a apiKeyAuth) apply(req *http.Request) (*http.Request, error) {
-	if a.token != "" {
-		req.Header.Set("Authorization", "Bearer "+a.token)
-	}
-	return req, nil
-}
-
-type iamAuth struct {
-	ak     string
-	sk     string
-	region string
-}
-
-func (a iamAuth) apply(req *http.Request) (*http.Request, error) {
-	req.Header.Set("X-Date", time.Now().UTC().Format(http.TimeFormat))
-	return req, nil
+	core    *core.Transport
+	baseURL *url.URL
+	region  string
 }
 
 func newTransport(cfg Config, authConfig Auth) (*transport, error) {
+	defaults := DefaultConfig()
+
 	if cfg.Endpoint == "" {
-		return nil, fmt.Errorf("endpoint cannot be empty")
+		return nil, model.NewInvalidParameterError("endpoint cannot be empty")
 	}
 
 	baseURL, err := url.Parse(cfg.Endpoint)
 	if err != nil {
-		return nil, fmt.Errorf("invalid endpoint: %w", err)
+		return nil, model.NewErrorWithCause(model.ErrCodeInvalidParameter, "invalid endpoint", err, http.StatusBadRequest)
 	}
 	if baseURL.Scheme == "" {
 		baseURL.Scheme = "http"
 	}
 
 	if cfg.Timeout <= 0 {
-		cfg.Timeout = DefaultConfig().Timeout
+		cfg.Timeout = defaults.Timeout
 	}
 
 	httpClient := cfg.HTTPClient
@@ -128,69 +78,39 @@ func newTransport(cfg Config, authConfig Auth) (*transport, error) {
 		userAgent = fmt.Sprintf("vikingdb-go-sdk/memory/%s", Version)
 	}
 
-	var auth authenticator = noAuth{}
-	switch authConfig.kind {
-	case authKindIAM:
-		auth = iamAuth{ak: authConfig.accessKey, sk: authConfig.secretKey, region: cfg.Region}
-	case authKindAPIKey:
-		auth = apiKeyAuth{token: authConfig.apiKey}
-	}
-
-	return &transport{
-		config:     cfg,
-		httpClient: httpClient,
-		baseURL:    baseURL,
-		auth:       auth,
-		userAgent:  userAgent,
-	}, nil
-}
-
-func (c *transport) doRequest(ctx context.Context, method, path string, request, response interface{}) error {
-	if ctx == nil {
-		ctx = context.Background()
-	}
-
-	var body []byte
-	if request != nil {
-		serialized, err := json.Marshal(request)
-		if err != nil {
-			return fmt.Errorf("failed to marshal request: %w", err)
-		}
-		body = serialized
+	if cfg.Region == "" {
+		cfg.Region = defaults.Region
 	}
-
-	targetURL := c.baseURL.ResolveReference(&url.URL{Path: path})
-	var buf *bytes.Reader
-	if len(body) > 0 {
-		buf = bytes.NewReader(body)
+	if cfg.Service == "" {
+		cfg.Service = defaults.Service
 	}
-	req, err := http.NewRequestWithContext(ctx, method, targetURL.String(), buf)
-	if err != nil {
-		return fmt.Errorf("failed to create request: %w", err)
-	}
-	req.Header.Set("Accept", "application/json")
-	if len(body) > 0 {
-		req.Header.Set("Content-Type", "application/json")
-	}
-	req.Header.Set("User-Agent", c.userAgent)
 
-	signedReq, err := c.auth.apply(req)
+	authenticator, err := auth.NewAuthenticator(authConfig, cfg.Service)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	resp, err := c.httpClient.Do(signedReq)
-	if err != nil {
-		return fmt.Errorf("request failed: %w", err)
-	}
-	defer resp.Body.Close()
+	return &transport{
+		core: core.NewTransport(core.Config{
+			HTTPClient:  httpClient,
+			Auth:        authenticator,
+			UserAgent:   userAgent,
+			MaxRetries:  cfg.MaxRetries,
+			RetryPolicy: cfg.RetryPolicy,
+		}),
+		baseURL: baseURL,
+		region:  cfg.Region,
+	}, nil
+}
 
-	if resp.StatusCode >= 400 {
-		return fmt.Errorf("request failed with status: %d", resp.StatusCode)
+// doRequest performs a request and decodes the reply into response. The memory service reports some
+// failures with HTTP 200 and a non-zero code, so those are turned into errors as well.
+func (c *transport) doRequest(ctx context.Context, method, path string, request, response interface{}, opts ...RequestOption) error {
+	if err := c.core.Do(ctx, c.baseURL, c.region, method, path, request, response, core.NewRequestOptions(opts...)); err != nil {
+		return err
 	}
-
-	if response != nil {
-		return json.NewDecoder(resp.Body).Decode(response)
+	if envelope, ok := response.(interface{ common() *CommonResponse }); ok {
+		return envelope.common().err()
 	}
 	return nil
 }
@@ -201,7 +121,7 @@ type Client struct {
 }
 
 // New creates a new Viking Memory client.
-func New(auth Auth, opts ...func(*Config)) (*Client, error) {
+func New(auth Auth, opts ...ClientOption) (*Client, error) {
 	cfg := DefaultConfig()
 	for _, opt := range opts {
 		opt(&cfg)
@@ -224,23 +144,10 @@ func (c *Client) Collection(collectionName, projectName string) *Collection {
 	}
 }
 
-// WithEndpoint sets the endpoint.
-func WithEndpoint(endpoint string) func(*Config) {
-	return func(c *Config) {
-		c.Endpoint = endpoint
-	}
-}
-
-// WithRegion sets the region.
-func WithRegion(region string) func(*Config) {
-	return func(c *Config) {
-		c.Region = region
-	}
-}
-
-// WithTimeout sets the timeout.
-func WithTimeout(timeout time.Duration) func(*Config) {
-	return func(c *Config) {
-		c.Timeout = timeout
+// CollectionByResourceID returns a Collection client addressing the collection by its resource ID.
+func (c *Client) CollectionByResourceID(resourceID string) *Collection {
+	return &Collection{
+		client:     c.transport,
+		resourceID: resourceID,
 	}
 }
@@ -0,0 +1,128 @@
+// Copyright (c) 2025 Beijing Volcano Engine Technology Co., Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package memory_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/volcengine/vikingdb-go-sdk/memory"
+	"github.com/volcengine/vikingdb-go-sdk/vector/model"
+	"github.com/volcengine/vikingdb-go-sdk/vector/utils"
+)
+
+func TestClientSignsRequests(t *testing.T) {
+	cases := []struct {
+		name     string
+		auth     memory.Auth
+		opts     []memory.ClientOption
+		wantAuth string
+		wantDate bool
+	}{
+		{name: "IAM signs for air in the default region", auth: memory.AuthIAM("AKTEST", "SKTEST"), wantAuth: "/cn-beijing/air/request", wantDate: true},
+		{name: "IAM signs for the configured region", auth: memory.AuthIAM("AKTEST", "SKTEST"), opts: []memory.ClientOption{memory.WithRegion("cn-shanghai")}, wantAuth: "/cn-shanghai/air/request", wantDate: true},
+		{name: "API key", auth: memory.AuthAPIKey("secret"), wantAuth: "Bearer secret"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			var header http.Header
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_, _ = io.Copy(ioutil.Discard, r.Body)
+				header = r.Header.Clone()
+				_, _ = w.Write([]byte(`{"code":0,"data":{"count":0,"result_list":[]}}`))
+			}))
+			defer srv.Close()
+			client, err := memory.New(tc.auth, append([]memory.ClientOption{memory.WithEndpoint(srv.URL)}, tc.opts...)...)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if _, err := client.Collection("c", "p").SearchMemory(context.Background(), memory.SearchMemoryRequest{Query: "q"}); err != nil {
+				t.Fatal(err)
+			}
+			if got := header.Get("Authorization"); !strings.Contains(got, tc.wantAuth) {
+				t.Fatalf("Authorization = %q, want it to contain %q", got, tc.wantAuth)
+			}
+			if got := header.Get("X-Date") != ""; got != tc.wantDate {
+				t.Fatalf("X-Date set = %v, want %v", got, tc.wantDate)
+			}
+		})
+	}
+}
+
+func TestClientParsesNumericErrorCodes(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = io.Copy(ioutil.Discard, r.Body)
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(`{"code":1000001,"message":"collection not found","request_id":"req-1"}`))
+	}))
+	defer srv.Close()
+	client, err := memory.New(memory.AuthAPIKey("secret"), memory.WithEndpoint(srv.URL))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = client.Collection("c", "p").GetSession(context.Background(), "s")
+	var sdkErr *model.Error
+	if !errors.As(err, &sdkErr) {
+		t.Fatalf("err = %#v, want *model.Error", err)
+	}
+	if sdkErr.Code != "1000001" || sdkErr.StatusCode != http.StatusBadRequest || sdkErr.Message != "collection not found" || sdkErr.RequestID != "req-1" {
+		t.Fatalf("err = %+v", sdkErr)
+	}
+}
+
+func TestClientRetries(t *testing.T) {
+	fast := memory.WithRetryPolicy(utils.RetryPolicy{InitialBackoff: time.Millisecond, MaxBackoff: time.Millisecond, Jitter: utils.JitterNone})
+	cases := []struct {
+		name         string
+		opts         []memory.ClientOption
+		requestOpts  []memory.RequestOption
+		status       int
+		failures     int32
+		wantErr      bool
+		wantRequests int32
+	}{
+		{name: "no retries by default", opts: []memory.ClientOption{fast}, status: http.StatusServiceUnavailable, failures: 1, wantErr: true, wantRequests: 1},
+		{name: "5xx is retried", opts: []memory.ClientOption{fast, memory.WithMaxRetries(2)}, status: http.StatusInternalServerError, failures: 2, wantRequests: 3},
+		{name: "retry budget runs out", opts: []memory.ClientOption{fast, memory.WithMaxRetries(1)}, status: http.StatusBadGateway, failures: 5, wantErr: true, wantRequests: 2},
+		{name: "per-request retries", opts: []memory.ClientOption{fast}, requestOpts: []memory.RequestOption{memory.WithRequestMaxRetries(1)}, status: http.StatusServiceUnavailable, failures: 1, wantRequests: 2},
+		{name: "4xx is not retried", opts: []memory.ClientOption{fast, memory.WithMaxRetries(3)}, status: http.StatusBadRequest, failures: 1, wantErr: true, wantRequests: 1},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			var requests int32
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_, _ = io.Copy(ioutil.Discard, r.Body)
+				if atomic.AddInt32(&requests, 1) <= tc.failures {
+					w.WriteHeader(tc.status)
+					_, _ = w.Write([]byte(`{"code":1000000,"message":"failed"}`))
+					return
+				}
+				_, _ = w.Write([]byte(`{"code":0,"data":{"session_id":"s"}}`))
+			}))
+			defer srv.Close()
+			client, err := memory.New(memory.AuthAPIKey("secret"), append([]memory.ClientOption{memory.WithEndpoint(srv.URL)}, tc.opts...)...)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			_, err = client.Collection("c", "p").GetSession(context.Background(), "s", tc.requestOpts...)
+			if tc.wantErr != (err != nil) {
+				t.Fatalf("err = %v, want error %v", err, tc.wantErr)
+			}
+			if got := atomic.LoadInt32(&requests); got != tc.wantRequests {
+				t.Fatalf("server saw %d requests, want %d", got, tc.wantRequests)
+			}
+		})
+	}
+}
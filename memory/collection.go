@@ -8,21 +8,17 @@ import (
 	"net/http"
 )
 
-// Message represents a chat message.
-type Message struct {
-	Role    string `json:"role"`
-	Content string `json:"content"`
-}
-
 // AddSessionRequest is the request for AddSession.
 type AddSessionRequest struct {
-	SessionID      string                   `json:"session_id"`
-	Messages       []Message                `json:"messages"`
-	Metadata       map[string]interface{}   `json:"metadata,omitempty"`
-	Profiles       []interface{}            `json:"profiles,omitempty"`
-	CollectionName string                   `json:"collection_name"`
-	ProjectName    string                   `json:"project_name"`
-	ResourceID     string                   `json:"resource_id,omitempty"`
+	SessionID      string                 `json:"session_id"`
+	Messages       []Message              `json:"messages"`
+	Metadata       map[string]interface{} `json:"metadata,omitempty"`
+	Profiles       []interface{}          `json:"profiles,omitempty"`
+	CollectionName string                 `json:"collection_name"`
+	ProjectName    string                 `json:"project_name"`
+	ResourceID     string                 `json:"resource_id,omitempty"`
+
+	requestOptions []RequestOption
 }
 
 // Collection represents a memory collection.
@@ -33,6 +29,11 @@ type Collection struct {
 	resourceID     string
 }
 
+// locator returns the collection identifiers sent with every request.
+func (c *Collection) locator() (collectionName, projectName, resourceID string) {
+	return c.collectionName, c.projectName, c.resourceID
+}
+
 // AddSession adds a session to the collection.
 func (c *Collection) AddSession(ctx context.Context, sessionID string, messages []Message, opts ...CollectionOption) error {
 	req := AddSessionRequest{
@@ -48,7 +49,7 @@ func (c *Collection) AddSession(ctx context.Context, sessionID string, messages
 	}
 
 	path := "/api/memory/session/add"
-	return c.client.doRequest(ctx, http.MethodPost, path, req, nil)
+	return c.client.doRequest(ctx, http.MethodPost, path, req, &CommonResponse{}, req.requestOptions...)
 }
 
 // CollectionOption is an option for collection operations.
@@ -67,3 +68,10 @@ func WithProfiles(profiles []interface{}) CollectionOption {
 		r.Profiles = profiles
 	}
 }
+
+// WithRequestOptions applies per-request options such as headers, request IDs or retry overrides.
+func WithRequestOptions(opts ...RequestOption) CollectionOption {
+	return func(r *AddSessionRequest) {
+		r.requestOptions = append(r.requestOptions, opts...)
+	}
+}
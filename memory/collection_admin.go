@@ -0,0 +1,244 @@
+// Copyright (c) 2025 Beijing Volcano Engine Technology Co., Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package memory
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/volcengine/vikingdb-go-sdk/vector/model"
+)
+
+// PropertySchema describes one property extracted into an event or profile.
+type PropertySchema struct {
+	PropertyName      string `json:"property_name"`
+	PropertyValueType string `json:"property_value_type"`
+	Description       string `json:"description,omitempty"`
+	// UseProvided keeps the value supplied in session metadata instead of extracting it.
+	UseProvided bool `json:"use_provided,omitempty"`
+}
+
+// EventTypeSchema declares a custom event type extracted from sessions.
+type EventTypeSchema struct {
+	EventType   string           `json:"event_type"`
+	Description string           `json:"description,omitempty"`
+	Properties  []PropertySchema `json:"properties"`
+}
+
+// ProfileTypeSchema declares a custom profile type accumulated per user.
+type ProfileTypeSchema struct {
+	ProfileType string           `json:"profile_type"`
+	Description string           `json:"description,omitempty"`
+	Properties  []PropertySchema `json:"properties"`
+}
+
+// CollectionInfo describes a memory collection.
+type CollectionInfo struct {
+	CollectionName           string              `json:"collection_name"`
+	ProjectName              string              `json:"project_name,omitempty"`
+	ResourceID               string              `json:"resource_id,omitempty"`
+	Description              string              `json:"description,omitempty"`
+	BuiltinEventTypes        []string            `json:"builtin_event_types,omitempty"`
+	BuiltinProfileTypes      []string            `json:"builtin_profile_types,omitempty"`
+	CustomEventTypeSchemas   []EventTypeSchema   `json:"custom_event_type_schemas,omitempty"`
+	CustomProfileTypeSchemas []ProfileTypeSchema `json:"custom_profile_type_schemas,omitempty"`
+	CreateTime               int64               `json:"create_time,omitempty"`
+	UpdateTime               int64               `json:"update_time,omitempty"`
+}
+
+// CreateCollectionRequest is the request for CreateCollection.
+type CreateCollectionRequest struct {
+	CollectionName           string              `json:"collection_name"`
+	ProjectName              string              `json:"project_name,omitempty"`
+	Description              string              `json:"description,omitempty"`
+	BuiltinEventTypes        []string            `json:"builtin_event_types,omitempty"`
+	BuiltinProfileTypes      []string            `json:"builtin_profile_types,omitempty"`
+	CustomEventTypeSchemas   []EventTypeSchema   `json:"custom_event_type_schemas,omitempty"`
+	CustomProfileTypeSchemas []ProfileTypeSchema `json:"custom_profile_type_schemas,omitempty"`
+}
+
+// CreateCollectionResult carries the identifiers of a new collection.
+type CreateCollectionResult struct {
+	ResourceID string `json:"resource_id"`
+}
+
+// CreateCollectionResponse is the response of CreateCollection.
+type CreateCollectionResponse struct {
+	CommonResponse
+	Data *CreateCollectionResult `json:"data,omitempty"`
+}
+
+// GetCollectionRequest is the request for GetCollection. Either CollectionName or ResourceID is required.
+type GetCollectionRequest struct {
+	CollectionName string `json:"collection_name,omitempty"`
+	ProjectName    string `json:"project_name,omitempty"`
+	ResourceID     string `json:"resource_id,omitempty"`
+}
+
+// GetCollectionResponse is the response of GetCollection.
+type GetCollectionResponse struct {
+	CommonResponse
+	Data *CollectionInfo `json:"data,omitempty"`
+}
+
+// ListCollectionsRequest is the request for ListCollections.
+type ListCollectionsRequest struct {
+	ProjectName string `json:"project_name,omitempty"`
+	Offset      int    `json:"offset,omitempty"`
+	Limit       int    `json:"limit,omitempty"`
+}
+
+// ListCollectionsResult holds a page of collections.
+type ListCollectionsResult struct {
+	Total       int              `json:"total"`
+	Collections []CollectionInfo `json:"collection_list"`
+}
+
+// ListCollectionsResponse is the response of ListCollections.
+type ListCollectionsResponse struct {
+	CommonResponse
+	Data *ListCollectionsResult `json:"data,omitempty"`
+}
+
+// UpdateCollectionRequest is the request for UpdateCollection. Either CollectionName or ResourceID is required.
+type UpdateCollectionRequest struct {
+	CollectionName           string              `json:"collection_name,omitempty"`
+	ProjectName              string              `json:"project_name,omitempty"`
+	ResourceID               string              `json:"resource_id,omitempty"`
+	Description              string              `json:"description,omitempty"`
+	BuiltinEventTypes        []string            `json:"builtin_event_types,omitempty"`
+	BuiltinProfileTypes      []string            `json:"builtin_profile_types,omitempty"`
+	CustomEventTypeSchemas   []EventTypeSchema   `json:"custom_event_type_schemas,omitempty"`
+	CustomProfileTypeSchemas []ProfileTypeSchema `json:"custom_profile_type_schemas,omitempty"`
+}
+
+// UpdateCollectionResponse is the response of UpdateCollection.
+type UpdateCollectionResponse struct {
+	CommonResponse
+}
+
+// DeleteCollectionRequest is the request for DeleteCollection. Either CollectionName or ResourceID is required.
+type DeleteCollectionRequest struct {
+	CollectionName string `json:"collection_name,omitempty"`
+	ProjectName    string `json:"project_name,omitempty"`
+	ResourceID     string `json:"resource_id,omitempty"`
+}
+
+// DeleteCollectionResponse is the response of DeleteCollection.
+type DeleteCollectionResponse struct {
+	CommonResponse
+}
+
+// CreateCollection provisions a memory collection with its event and profile types.
+func (c *Client) CreateCollection(ctx context.Context, request CreateCollectionRequest, opts ...RequestOption) (*CreateCollectionResponse, error) {
+	if request.CollectionName == "" {
+		return nil, model.NewInvalidParameterError("collection name cannot be empty")
+	}
+	if err := validateTypeSchemas(request.CustomEventTypeSchemas, request.CustomProfileTypeSchemas); err != nil {
+		return nil, err
+	}
+	resp := &CreateCollectionResponse{}
+	if err := c.transport.doRequest(ctx, http.MethodPost, "/api/memory/collection/create", request, resp, opts...); err != nil {
+		return resp, err
+	}
+	return resp, nil
+}
+
+// GetCollection describes a memory collection.
+func (c *Client) GetCollection(ctx context.Context, request GetCollectionRequest, opts ...RequestOption) (*GetCollectionResponse, error) {
+	if err := validateCollectionRef(request.CollectionName, request.ResourceID); err != nil {
+		return nil, err
+	}
+	resp := &GetCollectionResponse{}
+	if err := c.transport.doRequest(ctx, http.MethodPost, "/api/memory/collection/info", request, resp, opts...); err != nil {
+		return resp, err
+	}
+	return resp, nil
+}
+
+// ListCollections lists the memory collections of a project.
+func (c *Client) ListCollections(ctx context.Context, request ListCollectionsRequest, opts ...RequestOption) (*ListCollectionsResponse, error) {
+	resp := &ListCollectionsResponse{}
+	if err := c.transport.doRequest(ctx, http.MethodPost, "/api/memory/collection/list", request, resp, opts...); err != nil {
+		return resp, err
+	}
+	return resp, nil
+}
+
+// UpdateCollection changes the description and the event and profile types of a collection.
+func (c *Client) UpdateCollection(ctx context.Context, request UpdateCollectionRequest, opts ...RequestOption) (*UpdateCollectionResponse, error) {
+	if err := validateCollectionRef(request.CollectionName, request.ResourceID); err != nil {
+		return nil, err
+	}
+	if err := validateTypeSchemas(request.CustomEventTypeSchemas, request.CustomProfileTypeSchemas); err != nil {
+		return nil, err
+	}
+	resp := &UpdateCollectionResponse{}
+	if err := c.transport.doRequest(ctx, http.MethodPost, "/api/memory/collection/update", request, resp, opts...); err != nil {
+		return resp, err
+	}
+	return resp, nil
+}
+
+// DeleteCollection removes a collection and all of its memories.
+func (c *Client) DeleteCollection(ctx context.Context, request DeleteCollectionRequest, opts ...RequestOption) (*DeleteCollectionResponse, error) {
+	if err := validateCollectionRef(request.CollectionName, request.ResourceID); err != nil {
+		return nil, err
+	}
+	resp := &DeleteCollectionResponse{}
+	if err := c.transport.doRequest(ctx, http.MethodPost, "/api/memory/collection/delete", request, resp, opts...); err != nil {
+		return resp, err
+	}
+	return resp, nil
+}
+
+func validateCollectionRef(collectionName, resourceID string) error {
+	if collectionName == "" && resourceID == "" {
+		return model.NewInvalidParameterError("collection name or resource id is required")
+	}
+	return nil
+}
+
+func validateTypeSchemas(events []EventTypeSchema, profiles []ProfileTypeSchema) error {
+	seen := make(map[string]bool, len(events))
+	for _, event := range events {
+		if event.EventType == "" {
+			return model.NewInvalidParameterError("event type cannot be empty")
+		}
+		if seen[event.EventType] {
+			return model.NewInvalidParameterError(fmt.Sprintf("event type %q is declared twice", event.EventType))
+		}
+		seen[event.EventType] = true
+		if err := validateProperties("event type "+event.EventType, event.Properties); err != nil {
+			return err
+		}
+	}
+	seen = make(map[string]bool, len(profiles))
+	for _, profile := range profiles {
+		if profile.ProfileType == "" {
+			return model.NewInvalidParameterError("profile type cannot be empty")
+		}
+		if seen[profile.ProfileType] {
+			return model.NewInvalidParameterError(fmt.Sprintf("profile type %q is declared twice", profile.ProfileType))
+		}
+		seen[profile.ProfileType] = true
+		if err := validateProperties("profile type "+profile.ProfileType, profile.Properties); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func validateProperties(owner string, properties []PropertySchema) error {
+	if len(properties) == 0 {
+		return model.NewInvalidParameterError(fmt.Sprintf("%s: at least one property is required", owner))
+	}
+	for _, property := range properties {
+		if property.PropertyName == "" || property.PropertyValueType == "" {
+			return model.NewInvalidParameterError(fmt.Sprintf("%s: property name and value type cannot be empty", owner))
+		}
+	}
+	return nil
+}
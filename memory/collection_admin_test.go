@@ -0,0 +1,152 @@
+// Copyright (c) 2025 Beijing Volcano Engine Technology Co., Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package memory_test
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/volcengine/vikingdb-go-sdk/memory"
+	"github.com/volcengine/vikingdb-go-sdk/vector/model"
+)
+
+func TestCollectionAdminRoundTrip(t *testing.T) {
+	schema := memory.EventTypeSchema{EventType: "plan", Properties: []memory.PropertySchema{{PropertyName: "trip", PropertyValueType: "string"}}}
+	info := `{"collection_name":"c","project_name":"p","resource_id":"r","builtin_event_types":["preference"],"create_time":1}`
+	wantInfo := memory.CollectionInfo{CollectionName: "c", ProjectName: "p", ResourceID: "r", BuiltinEventTypes: []string{"preference"}, CreateTime: 1}
+
+	cases := []struct {
+		name        string
+		reply       string
+		call        func(*memory.Client) (interface{}, error)
+		wantPath    string
+		wantRequest string
+		want        interface{}
+	}{
+		{
+			name:  "create",
+			reply: `{"code":0,"data":{"resource_id":"r"}}`,
+			call: func(c *memory.Client) (interface{}, error) {
+				resp, err := c.CreateCollection(context.Background(), memory.CreateCollectionRequest{
+					CollectionName: "c", ProjectName: "p", BuiltinEventTypes: []string{"preference"}, CustomEventTypeSchemas: []memory.EventTypeSchema{schema},
+				})
+				return resp.Data, err
+			},
+			wantPath: "/api/memory/collection/create",
+			wantRequest: `{"collection_name":"c","project_name":"p","builtin_event_types":["preference"],
+				"custom_event_type_schemas":[{"event_type":"plan","properties":[{"property_name":"trip","property_value_type":"string"}]}]}`,
+			want: &memory.CreateCollectionResult{ResourceID: "r"},
+		},
+		{
+			name:  "get by resource ID",
+			reply: `{"code":0,"data":` + info + `}`,
+			call: func(c *memory.Client) (interface{}, error) {
+				resp, err := c.GetCollection(context.Background(), memory.GetCollectionRequest{ResourceID: "r"})
+				return resp.Data, err
+			},
+			wantPath:    "/api/memory/collection/info",
+			wantRequest: `{"resource_id":"r"}`,
+			want:        &wantInfo,
+		},
+		{
+			name:  "list",
+			reply: `{"code":0,"data":{"total":1,"collection_list":[` + info + `]}}`,
+			call: func(c *memory.Client) (interface{}, error) {
+				resp, err := c.ListCollections(context.Background(), memory.ListCollectionsRequest{ProjectName: "p", Limit: 10})
+				return resp.Data, err
+			},
+			wantPath:    "/api/memory/collection/list",
+			wantRequest: `{"project_name":"p","limit":10}`,
+			want:        &memory.ListCollectionsResult{Total: 1, Collections: []memory.CollectionInfo{wantInfo}},
+		},
+		{
+			name:  "update",
+			reply: `{"code":0,"request_id":"req-1"}`,
+			call: func(c *memory.Client) (interface{}, error) {
+				resp, err := c.UpdateCollection(context.Background(), memory.UpdateCollectionRequest{CollectionName: "c", ProjectName: "p", Description: "d"})
+				return resp.RequestID, err
+			},
+			wantPath:    "/api/memory/collection/update",
+			wantRequest: `{"collection_name":"c","project_name":"p","description":"d"}`,
+			want:        "req-1",
+		},
+		{
+			name:  "delete",
+			reply: `{"code":0,"request_id":"req-2"}`,
+			call: func(c *memory.Client) (interface{}, error) {
+				resp, err := c.DeleteCollection(context.Background(), memory.DeleteCollectionRequest{CollectionName: "c"})
+				return resp.RequestID, err
+			},
+			wantPath:    "/api/memory/collection/delete",
+			wantRequest: `{"collection_name":"c"}`,
+			want:        "req-2",
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			client, path, body := newRecordingServer(t, tc.reply)
+
+			got, err := tc.call(client)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if *path != tc.wantPath {
+				t.Fatalf("path = %q, want %q", *path, tc.wantPath)
+			}
+			if want := jsonValue(t, tc.wantRequest); !reflect.DeepEqual(*body, want) {
+				t.Fatalf("request = %v, want %v", *body, want)
+			}
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Fatalf("response = %+v, want %+v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestCollectionAdminValidates(t *testing.T) {
+	empty := memory.PropertySchema{}
+	cases := []struct {
+		name string
+		call func(*memory.Client) error
+	}{
+		{name: "create without name", call: func(c *memory.Client) error {
+			_, err := c.CreateCollection(context.Background(), memory.CreateCollectionRequest{})
+			return err
+		}},
+		{name: "create with duplicate event types", call: func(c *memory.Client) error {
+			schema := memory.EventTypeSchema{EventType: "plan", Properties: []memory.PropertySchema{{PropertyName: "trip", PropertyValueType: "string"}}}
+			_, err := c.CreateCollection(context.Background(), memory.CreateCollectionRequest{CollectionName: "c", CustomEventTypeSchemas: []memory.EventTypeSchema{schema, schema}})
+			return err
+		}},
+		{name: "update with an incomplete property", call: func(c *memory.Client) error {
+			_, err := c.UpdateCollection(context.Background(), memory.UpdateCollectionRequest{ResourceID: "r",
+				CustomProfileTypeSchemas: []memory.ProfileTypeSchema{{ProfileType: "basic", Properties: []memory.PropertySchema{empty}}}})
+			return err
+		}},
+		{name: "get without a reference", call: func(c *memory.Client) error {
+			_, err := c.GetCollection(context.Background(), memory.GetCollectionRequest{ProjectName: "p"})
+			return err
+		}},
+		{name: "delete without a reference", call: func(c *memory.Client) error {
+			_, err := c.DeleteCollection(context.Background(), memory.DeleteCollectionRequest{})
+			return err
+		}},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			client, path, _ := newRecordingServer(t, `{"code":0}`)
+
+			err := tc.call(client)
+			var sdkErr *model.Error
+			if !errors.As(err, &sdkErr) || sdkErr.Code != model.ErrCodeInvalidParameter {
+				t.Fatalf("err = %v, want an InvalidParameter error", err)
+			}
+			if *path != "" {
+				t.Fatalf("request sent to %q", *path)
+			}
+		})
+	}
+}
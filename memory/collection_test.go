@@ -0,0 +1,86 @@
+// Copyright (c) 2025 Beijing Volcano Engine Technology Co., Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package memory_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/volcengine/vikingdb-go-sdk/memory"
+)
+
+// The service has always received collection_name and project_name on data-plane calls, even when empty,
+// so addressing a collection by resource ID must not drop them from the body.
+func TestCollectionAddressing(t *testing.T) {
+	calls := []struct {
+		name string
+		call func(*memory.Collection) error
+	}{
+		{name: "add session", call: func(c *memory.Collection) error {
+			return c.AddSession(context.Background(), "s", []memory.Message{memory.NewMessage(memory.RoleUser, "hi")})
+		}},
+		{name: "search", call: func(c *memory.Collection) error {
+			_, err := c.SearchMemory(context.Background(), memory.SearchMemoryRequest{Query: "q"})
+			return err
+		}},
+		{name: "list sessions", call: func(c *memory.Collection) error {
+			_, err := c.ListSessions(context.Background(), memory.ListSessionsRequest{})
+			return err
+		}},
+		{name: "get session", call: func(c *memory.Collection) error {
+			_, err := c.GetSession(context.Background(), "s")
+			return err
+		}},
+		{name: "delete session", call: func(c *memory.Collection) error {
+			_, err := c.DeleteSession(context.Background(), "s")
+			return err
+		}},
+		{name: "list events", call: func(c *memory.Collection) error {
+			_, err := c.ListEvents(context.Background(), memory.ListEventsRequest{})
+			return err
+		}},
+		{name: "get profile", call: func(c *memory.Collection) error {
+			_, err := c.GetProfile(context.Background(), memory.GetProfileRequest{})
+			return err
+		}},
+		{name: "update profile", call: func(c *memory.Collection) error {
+			_, err := c.UpdateProfile(context.Background(), memory.UpdateProfileRequest{})
+			return err
+		}},
+	}
+	locators := []struct {
+		name       string
+		collection func(*memory.Client) *memory.Collection
+		want       map[string]interface{}
+	}{
+		{
+			name:       "by name",
+			collection: func(c *memory.Client) *memory.Collection { return c.Collection("c", "p") },
+			want:       map[string]interface{}{"collection_name": "c", "project_name": "p"},
+		},
+		{
+			name:       "by resource ID",
+			collection: func(c *memory.Client) *memory.Collection { return c.CollectionByResourceID("r") },
+			want:       map[string]interface{}{"collection_name": "", "project_name": "", "resource_id": "r"},
+		},
+	}
+	for _, locator := range locators {
+		for _, tc := range calls {
+			t.Run(locator.name+"/"+tc.name, func(t *testing.T) {
+				client, _, body := newRecordingServer(t, `{"code":0}`)
+
+				if err := tc.call(locator.collection(client)); err != nil {
+					t.Fatal(err)
+				}
+				for _, key := range []string{"collection_name", "project_name", "resource_id"} {
+					got, ok := (*body)[key]
+					want, wantOK := locator.want[key]
+					if ok != wantOK || got != want {
+						t.Fatalf("%s = %v (present %v), want %v (present %v)", key, got, ok, want, wantOK)
+					}
+				}
+			})
+		}
+	}
+}
@@ -0,0 +1,101 @@
+// Copyright (c) 2025 Beijing Volcano Engine Technology Co., Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package memory
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/volcengine/vikingdb-go-sdk/vector/utils"
+)
+
+// Version denotes the SDK version.
+const Version = "0.1.0"
+
+// Config carries the settings of a memory client.
+type Config struct {
+	Endpoint string
+	Region   string
+	// Service is the service name used when signing IAM requests.
+	Service string
+	Timeout time.Duration
+	// MaxRetries defaults to 0: writes such as AddSession are not idempotent, so a retried request could
+	// store a session twice. Enable retries with WithMaxRetries, or per call with WithRequestMaxRetries.
+	MaxRetries int
+	HTTPClient *http.Client
+	UserAgent  string
+
+	// RetryPolicy controls backoff and retry classification; utils.DefaultRetryPolicy applies when nil.
+	RetryPolicy *utils.RetryPolicy
+}
+
+// DefaultConfig returns the baseline configuration.
+func DefaultConfig() Config {
+	return Config{
+		Endpoint: "http://api-knowledgebase.mlp.cn-beijing.volces.com",
+		Region:   "cn-beijing",
+		Service:  "air",
+		Timeout:  30 * time.Second,
+	}
+}
+
+// ClientOption mutates the client configuration before client creation.
+type ClientOption func(*Config)
+
+// WithEndpoint sets the endpoint.
+func WithEndpoint(endpoint string) ClientOption {
+	return func(c *Config) {
+		c.Endpoint = endpoint
+	}
+}
+
+// WithRegion sets the region.
+func WithRegion(region string) ClientOption {
+	return func(c *Config) {
+		c.Region = region
+	}
+}
+
+// WithService sets the service name used for IAM signing.
+func WithService(service string) ClientOption {
+	return func(c *Config) {
+		c.Service = service
+	}
+}
+
+// WithTimeout sets the timeout.
+func WithTimeout(timeout time.Duration) ClientOption {
+	return func(c *Config) {
+		c.Timeout = timeout
+	}
+}
+
+// WithMaxRetries sets the default retry count for retryable failures. Retries apply to every call,
+// including non-idempotent writes.
+func WithMaxRetries(maxRetries int) ClientOption {
+	return func(c *Config) {
+		c.MaxRetries = maxRetries
+	}
+}
+
+// WithRetryPolicy sets the client-wide backoff and retry classification policy.
+func WithRetryPolicy(policy utils.RetryPolicy) ClientOption {
+	return func(c *Config) {
+		c.RetryPolicy = &policy
+	}
+}
+
+// WithHTTPClient sets the HTTP client used to execute requests.
+func WithHTTPClient(httpClient *http.Client) ClientOption {
+	return func(c *Config) {
+		c.HTTPClient = httpClient
+	}
+}
+
+// WithUserAgent overrides the User-Agent header.
+func WithUserAgent(userAgent string) ClientOption {
+	return func(c *Config) {
+		c.UserAgent = userAgent
+	}
+}
@@ -0,0 +1,41 @@
+// Copyright (c) 2025 Beijing Volcano Engine Technology Co., Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package memory
+
+import (
+	"context"
+	"net/http"
+)
+
+// ListEventsRequest is the request for ListEvents.
+type ListEventsRequest struct {
+	Filter         *MemoryFilter `json:"filter,omitempty"`
+	Offset         int           `json:"offset,omitempty"`
+	Limit          int           `json:"limit,omitempty"`
+	CollectionName string        `json:"collection_name"`
+	ProjectName    string        `json:"project_name"`
+	ResourceID     string        `json:"resource_id,omitempty"`
+}
+
+// ListEventsResult holds a page of events.
+type ListEventsResult struct {
+	Total  int     `json:"total"`
+	Events []Event `json:"event_list"`
+}
+
+// ListEventsResponse is the response of ListEvents.
+type ListEventsResponse struct {
+	CommonResponse
+	Data *ListEventsResult `json:"data,omitempty"`
+}
+
+// ListEvents lists the events extracted from the collection's sessions.
+func (c *Collection) ListEvents(ctx context.Context, request ListEventsRequest, opts ...RequestOption) (*ListEventsResponse, error) {
+	request.CollectionName, request.ProjectName, request.ResourceID = c.locator()
+	resp := &ListEventsResponse{}
+	if err := c.client.doRequest(ctx, http.MethodPost, "/api/memory/event/list", request, resp, opts...); err != nil {
+		return resp, err
+	}
+	return resp, nil
+}
@@ -0,0 +1,42 @@
+// Copyright (c) 2025 Beijing Volcano Engine Technology Co., Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package memory_test
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/volcengine/vikingdb-go-sdk/memory"
+)
+
+func TestListEventsRoundTrip(t *testing.T) {
+	client, path, body := newRecordingServer(t, `{"code":0,"data":{"total":3,"event_list":[
+		{"event_id":"e1","event_type":"plan","session_id":"s1","user_id":["u1"],"content":{"trip":"Hangzhou"},"time":1700000000000,"update_time":1700000000001}]}}`)
+
+	resp, err := client.Collection("c", "p").ListEvents(context.Background(), memory.ListEventsRequest{
+		Filter: &memory.MemoryFilter{UserID: []string{"u1"}, MemoryType: []string{"plan"}, TimeRange: memory.TimeRange{StartTime: 1, EndTime: 2}},
+		Offset: 2,
+		Limit:  1,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if *path != "/api/memory/event/list" {
+		t.Fatalf("path = %q", *path)
+	}
+	want := jsonValue(t, `{"filter":{"user_id":["u1"],"memory_type":["plan"],"start_time":1,"end_time":2},
+		"offset":2,"limit":1,"collection_name":"c","project_name":"p"}`)
+	if !reflect.DeepEqual(*body, want) {
+		t.Fatalf("request = %v, want %v", *body, want)
+	}
+
+	wantResult := &memory.ListEventsResult{Total: 3, Events: []memory.Event{{
+		EventID: "e1", EventType: "plan", SessionID: "s1", UserID: []string{"u1"},
+		Content: map[string]interface{}{"trip": "Hangzhou"}, Time: 1700000000000, UpdateTime: 1700000000001,
+	}}}
+	if !reflect.DeepEqual(resp.Data, wantResult) {
+		t.Fatalf("response = %+v, want %+v", resp.Data, wantResult)
+	}
+}
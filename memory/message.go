@@ -0,0 +1,207 @@
+// Copyright (c) 2025 Beijing Volcano Engine Technology Co., Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package memory
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/volcengine/vikingdb-go-sdk/vector/model"
+)
+
+// Message roles understood by the memory service.
+const (
+	RoleSystem    = "system"
+	RoleUser      = "user"
+	RoleAssistant = "assistant"
+	RoleTool      = "tool"
+)
+
+// Content part types.
+const (
+	ContentPartText     = "text"
+	ContentPartImageURL = "image_url"
+)
+
+// Message represents a chat message. Content carries the plain text sent to the service; Parts, when set,
+// carry the structured (text and image) content and Content defaults to the concatenation of their text.
+type Message struct {
+	Role    string `json:"role"`
+	Content string `json:"content"`
+	// Name identifies the speaker, e.g. a user or assistant display name.
+	Name string `json:"name,omitempty"`
+	// Time is the Unix time of the message in milliseconds.
+	Time     int64                  `json:"time,omitempty"`
+	Metadata map[string]interface{} `json:"metadata,omitempty"`
+	Parts    []ContentPart          `json:"content_parts,omitempty"`
+	// ToolCalls lists the tools invoked by an assistant message.
+	ToolCalls []ToolCall `json:"tool_calls,omitempty"`
+	// ToolCallID links a tool result message to the call it answers.
+	ToolCallID string `json:"tool_call_id,omitempty"`
+}
+
+// ContentPart is one piece of multimodal message content.
+type ContentPart struct {
+	Type     string    `json:"type"`
+	Text     string    `json:"text,omitempty"`
+	ImageURL *ImageURL `json:"image_url,omitempty"`
+}
+
+// ImageURL references an image by URL or data URI.
+type ImageURL struct {
+	URL    string `json:"url"`
+	Detail string `json:"detail,omitempty"`
+}
+
+// ToolCall is a function invocation requested by the assistant.
+type ToolCall struct {
+	ID       string       `json:"id"`
+	Type     string       `json:"type"`
+	Function FunctionCall `json:"function"`
+}
+
+// FunctionCall names the invoked function and its JSON encoded arguments.
+type FunctionCall struct {
+	Name      string `json:"name"`
+	Arguments string `json:"arguments"`
+}
+
+// TextPart returns a text content part.
+func TextPart(text string) ContentPart {
+	return ContentPart{Type: ContentPartText, Text: text}
+}
+
+// ImagePart returns an image content part.
+func ImagePart(url string) ContentPart {
+	return ContentPart{Type: ContentPartImageURL, ImageURL: &ImageURL{URL: url}}
+}
+
+// NewMessage returns a message with the given role and text.
+func NewMessage(role, content string) Message {
+	return Message{Role: role, Content: content}
+}
+
+// UserMessage returns a user message.
+func UserMessage(content string) Message {
+	return NewMessage(RoleUser, content)
+}
+
+// AssistantMessage returns an assistant message.
+func AssistantMessage(content string) Message {
+	return NewMessage(RoleAssistant, content)
+}
+
+// SystemMessage returns a system message.
+func SystemMessage(content string) Message {
+	return NewMessage(RoleSystem, content)
+}
+
+// ToolResultMessage returns the result of the tool call identified by toolCallID.
+func ToolResultMessage(toolCallID, content string) Message {
+	return Message{Role: RoleTool, Content: content, ToolCallID: toolCallID}
+}
+
+// WithTime returns a copy of m stamped with t.
+func (m Message) WithTime(t time.Time) Message {
+	m.Time = t.UnixNano() / int64(time.Millisecond)
+	return m
+}
+
+// WithName returns a copy of m attributed to the speaker name.
+func (m Message) WithName(name string) Message {
+	m.Name = name
+	return m
+}
+
+// WithParts returns a copy of m with structured content parts.
+func (m Message) WithParts(parts ...ContentPart) Message {
+	m.Parts = append([]ContentPart(nil), parts...)
+	return m
+}
+
+// Text returns the text of the message, joining text parts when Content is empty.
+func (m Message) Text() string {
+	if m.Content != "" || len(m.Parts) == 0 {
+		return m.Content
+	}
+	texts := make([]string, 0, len(m.Parts))
+	for _, part := range m.Parts {
+		if part.Type == ContentPartText && part.Text != "" {
+			texts = append(texts, part.Text)
+		}
+	}
+	return strings.Join(texts, "\n")
+}
+
+// MarshalJSON fills content from the text parts so the service always receives plain text.
+func (m Message) MarshalJSON() ([]byte, error) {
+	type plain Message
+	out := plain(m)
+	out.Content = m.Text()
+	return json.Marshal(out)
+}
+
+// ChatCompletionMessage mirrors the message shape of OpenAI-compatible chat completion APIs, whose content is
+// either a string or an array of content parts.
+type ChatCompletionMessage struct {
+	Role       string          `json:"role"`
+	Content    json.RawMessage `json:"content,omitempty"`
+	Name       string          `json:"name,omitempty"`
+	ToolCalls  []ToolCall      `json:"tool_calls,omitempty"`
+	ToolCallID string          `json:"tool_call_id,omitempty"`
+}
+
+// FromChatCompletion converts chat completion messages into memory messages.
+func FromChatCompletion(messages []ChatCompletionMessage) ([]Message, error) {
+	out := make([]Message, 0, len(messages))
+	for i, message := range messages {
+		converted, err := message.ToMessage()
+		if err != nil {
+			return nil, model.NewInvalidParameterError(fmt.Sprintf("message %d: %v", i, err))
+		}
+		out = append(out, converted)
+	}
+	return out, nil
+}
+
+// FromChatCompletionJSON decodes a JSON array of chat completion messages into memory messages.
+func FromChatCompletionJSON(data []byte) ([]Message, error) {
+	var messages []ChatCompletionMessage
+	if err := json.Unmarshal(data, &messages); err != nil {
+		return nil, model.NewErrorWithCause(model.ErrCodeInvalidParameter, "failed to decode chat completion messages", err, http.StatusBadRequest)
+	}
+	return FromChatCompletion(messages)
+}
+
+// ToMessage converts m into a memory message.
+func (m ChatCompletionMessage) ToMessage() (Message, error) {
+	if m.Role == "" {
+		return Message{}, fmt.Errorf("role cannot be empty")
+	}
+	message := Message{
+		Role:       m.Role,
+		Name:       m.Name,
+		ToolCalls:  m.ToolCalls,
+		ToolCallID: m.ToolCallID,
+	}
+	raw := bytes.TrimSpace(m.Content)
+	switch {
+	case len(raw) == 0 || bytes.Equal(raw, []byte("null")):
+	case raw[0] == '"':
+		if err := json.Unmarshal(raw, &message.Content); err != nil {
+			return Message{}, err
+		}
+	case raw[0] == '[':
+		if err := json.Unmarshal(raw, &message.Parts); err != nil {
+			return Message{}, err
+		}
+	default:
+		return Message{}, fmt.Errorf("content must be a string or an array of parts")
+	}
+	return message, nil
+}
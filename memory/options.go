@@ -0,0 +1,50 @@
+// Copyright (c) 2025 Beijing Volcano Engine Technology Co., Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package memory
+
+import (
+	"github.com/volcengine/vikingdb-go-sdk/internal/core"
+	"github.com/volcengine/vikingdb-go-sdk/vector/utils"
+)
+
+// RequestOptions captures per-request overrides for retries, headers, and query params.
+type RequestOptions = core.RequestOptions
+
+// RequestOption mutates RequestOptions when constructing a request.
+type RequestOption = core.RequestOption
+
+// WithRequestMaxRetries limits the retry count for the current request.
+func WithRequestMaxRetries(maxRetries int) RequestOption {
+	return core.WithMaxRetries(maxRetries)
+}
+
+// WithRequestRetryPolicy overrides the client retry policy for the current request.
+func WithRequestRetryPolicy(policy utils.RetryPolicy) RequestOption {
+	return core.WithRetryPolicy(policy)
+}
+
+// WithRequestHeader sets a single header value for the request.
+func WithRequestHeader(key, value string) RequestOption {
+	return core.WithHeaders(map[string]string{key: value})
+}
+
+// WithRequestHeaders merges the provided headers into the request.
+func WithRequestHeaders(headers map[string]string) RequestOption {
+	return core.WithHeaders(headers)
+}
+
+// WithRequestQueryParam adds a single query parameter to the request.
+func WithRequestQueryParam(key, value string) RequestOption {
+	return core.WithQueryParams(map[string]string{key: value})
+}
+
+// WithRequestQueryParams merges the provided query parameters into the request.
+func WithRequestQueryParams(params map[string]string) RequestOption {
+	return core.WithQueryParams(params)
+}
+
+// WithRequestID sets the request id which will be propagated as X-Tt-Logid.
+func WithRequestID(requestID string) RequestOption {
+	return core.WithRequestID(requestID)
+}
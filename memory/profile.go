@@ -0,0 +1,69 @@
+// Copyright (c) 2025 Beijing Volcano Engine Technology Co., Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package memory
+
+import (
+	"context"
+	"net/http"
+)
+
+// GetProfileRequest is the request for GetProfile.
+// UserID and AssistantID are lists, as in MemoryFilter and Profile.
+type GetProfileRequest struct {
+	UserID         []string `json:"user_id"`
+	AssistantID    []string `json:"assistant_id,omitempty"`
+	ProfileType    string   `json:"profile_type,omitempty"`
+	CollectionName string   `json:"collection_name"`
+	ProjectName    string   `json:"project_name"`
+	ResourceID     string   `json:"resource_id,omitempty"`
+}
+
+// GetProfileResult holds the profiles of a user.
+type GetProfileResult struct {
+	Profiles []Profile `json:"profile_list"`
+}
+
+// GetProfileResponse is the response of GetProfile.
+type GetProfileResponse struct {
+	CommonResponse
+	Data *GetProfileResult `json:"data,omitempty"`
+}
+
+// UpdateProfileRequest is the request for UpdateProfile.
+type UpdateProfileRequest struct {
+	ProfileID      string                 `json:"profile_id,omitempty"`
+	ProfileType    string                 `json:"profile_type"`
+	UserID         []string               `json:"user_id"`
+	AssistantID    []string               `json:"assistant_id,omitempty"`
+	Content        map[string]interface{} `json:"content"`
+	CollectionName string                 `json:"collection_name"`
+	ProjectName    string                 `json:"project_name"`
+	ResourceID     string                 `json:"resource_id,omitempty"`
+}
+
+// UpdateProfileResponse is the response of UpdateProfile.
+type UpdateProfileResponse struct {
+	CommonResponse
+	Data *Profile `json:"data,omitempty"`
+}
+
+// GetProfile reads the profiles of a user, optionally restricted to one profile type.
+func (c *Collection) GetProfile(ctx context.Context, request GetProfileRequest, opts ...RequestOption) (*GetProfileResponse, error) {
+	request.CollectionName, request.ProjectName, request.ResourceID = c.locator()
+	resp := &GetProfileResponse{}
+	if err := c.client.doRequest(ctx, http.MethodPost, "/api/memory/profile/info", request, resp, opts...); err != nil {
+		return resp, err
+	}
+	return resp, nil
+}
+
+// UpdateProfile overwrites the content of a user profile.
+func (c *Collection) UpdateProfile(ctx context.Context, request UpdateProfileRequest, opts ...RequestOption) (*UpdateProfileResponse, error) {
+	request.CollectionName, request.ProjectName, request.ResourceID = c.locator()
+	resp := &UpdateProfileResponse{}
+	if err := c.client.doRequest(ctx, http.MethodPost, "/api/memory/profile/update", request, resp, opts...); err != nil {
+		return resp, err
+	}
+	return resp, nil
+}
@@ -0,0 +1,67 @@
+// Copyright (c) 2025 Beijing Volcano Engine Technology Co., Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package memory_test
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/volcengine/vikingdb-go-sdk/memory"
+)
+
+func TestGetProfileRoundTrip(t *testing.T) {
+	client, path, body := newRecordingServer(t, `{"code":0,"data":{"profile_list":[
+		{"profile_id":"pf1","profile_type":"basic","user_id":["u1"],"assistant_id":["a1"],"content":{"city":"Beijing"},"update_time":1700000000000}]}}`)
+
+	resp, err := client.Collection("c", "p").GetProfile(context.Background(), memory.GetProfileRequest{
+		UserID: []string{"u1"}, AssistantID: []string{"a1"}, ProfileType: "basic",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if *path != "/api/memory/profile/info" {
+		t.Fatalf("path = %q", *path)
+	}
+	want := jsonValue(t, `{"user_id":["u1"],"assistant_id":["a1"],"profile_type":"basic","collection_name":"c","project_name":"p"}`)
+	if !reflect.DeepEqual(*body, want) {
+		t.Fatalf("request = %v, want %v", *body, want)
+	}
+
+	wantProfiles := []memory.Profile{{
+		ProfileID: "pf1", ProfileType: "basic", UserID: []string{"u1"}, AssistantID: []string{"a1"},
+		Content: map[string]interface{}{"city": "Beijing"}, UpdateTime: 1700000000000,
+	}}
+	if resp.Data == nil || !reflect.DeepEqual(resp.Data.Profiles, wantProfiles) {
+		t.Fatalf("response = %+v, want profiles %+v", resp.Data, wantProfiles)
+	}
+}
+
+func TestUpdateProfileRoundTrip(t *testing.T) {
+	client, path, body := newRecordingServer(t, `{"code":0,"data":
+		{"profile_id":"pf1","profile_type":"basic","user_id":["u1"],"content":{"city":"Shanghai"},"update_time":1700000000001}}`)
+
+	resp, err := client.CollectionByResourceID("r").UpdateProfile(context.Background(), memory.UpdateProfileRequest{
+		ProfileID: "pf1", ProfileType: "basic", UserID: []string{"u1"}, Content: map[string]interface{}{"city": "Shanghai"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if *path != "/api/memory/profile/update" {
+		t.Fatalf("path = %q", *path)
+	}
+	want := jsonValue(t, `{"profile_id":"pf1","profile_type":"basic","user_id":["u1"],"content":{"city":"Shanghai"},
+		"collection_name":"","project_name":"","resource_id":"r"}`)
+	if !reflect.DeepEqual(*body, want) {
+		t.Fatalf("request = %v, want %v", *body, want)
+	}
+
+	wantProfile := &memory.Profile{
+		ProfileID: "pf1", ProfileType: "basic", UserID: []string{"u1"},
+		Content: map[string]interface{}{"city": "Shanghai"}, UpdateTime: 1700000000001,
+	}
+	if !reflect.DeepEqual(resp.Data, wantProfile) {
+		t.Fatalf("response = %+v, want %+v", resp.Data, wantProfile)
+	}
+}
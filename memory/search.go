@@ -0,0 +1,53 @@
+// Copyright (c) 2025 Beijing Volcano Engine Technology Co., Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package memory
+
+import (
+	"context"
+	"net/http"
+)
+
+// SearchMemoryRequest is the request for SearchMemory.
+type SearchMemoryRequest struct {
+	Query          string        `json:"query"`
+	Filter         *MemoryFilter `json:"filter,omitempty"`
+	Limit          int           `json:"limit,omitempty"`
+	CollectionName string        `json:"collection_name"`
+	ProjectName    string        `json:"project_name"`
+	ResourceID     string        `json:"resource_id,omitempty"`
+}
+
+// MemoryItem is a single search hit, either an event or a profile.
+type MemoryItem struct {
+	ID          string                 `json:"id"`
+	MemoryType  string                 `json:"memory_type"`
+	Score       float64                `json:"score"`
+	UserID      []string               `json:"user_id,omitempty"`
+	AssistantID []string               `json:"assistant_id,omitempty"`
+	SessionID   string                 `json:"session_id,omitempty"`
+	Content     map[string]interface{} `json:"content,omitempty"`
+	Time        int64                  `json:"time,omitempty"`
+}
+
+// SearchMemoryResult holds the hits of a search.
+type SearchMemoryResult struct {
+	Count int          `json:"count"`
+	Items []MemoryItem `json:"result_list"`
+}
+
+// SearchMemoryResponse is the response of SearchMemory.
+type SearchMemoryResponse struct {
+	CommonResponse
+	Data *SearchMemoryResult `json:"data,omitempty"`
+}
+
+// SearchMemory runs a semantic search over the memories stored in the collection.
+func (c *Collection) SearchMemory(ctx context.Context, request SearchMemoryRequest, opts ...RequestOption) (*SearchMemoryResponse, error) {
+	request.CollectionName, request.ProjectName, request.ResourceID = c.locator()
+	resp := &SearchMemoryResponse{}
+	if err := c.client.doRequest(ctx, http.MethodPost, "/api/memory/search", request, resp, opts...); err != nil {
+		return resp, err
+	}
+	return resp, nil
+}
@@ -0,0 +1,148 @@
+// Copyright (c) 2025 Beijing Volcano Engine Technology Co., Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package memory_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/volcengine/vikingdb-go-sdk/memory"
+	"github.com/volcengine/vikingdb-go-sdk/vector/model"
+)
+
+// newRecordingServer answers every request with reply and stores the last request path and body.
+func newRecordingServer(t *testing.T, reply string) (*memory.Client, *string, *map[string]interface{}) {
+	t.Helper()
+	var path string
+	body := map[string]interface{}{}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		raw, _ := ioutil.ReadAll(r.Body)
+		path = r.URL.Path
+		body = map[string]interface{}{}
+		if err := json.Unmarshal(raw, &body); err != nil {
+			t.Errorf("request body %q: %v", raw, err)
+		}
+		_, _ = w.Write([]byte(reply))
+	}))
+	t.Cleanup(srv.Close)
+	client, err := memory.New(memory.AuthAPIKey("secret"), memory.WithEndpoint(srv.URL))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return client, &path, &body
+}
+
+// jsonValue decodes s the way request bodies are decoded, so expectations compare as generic JSON.
+func jsonValue(t *testing.T, s string) map[string]interface{} {
+	t.Helper()
+	v := map[string]interface{}{}
+	if err := json.Unmarshal([]byte(s), &v); err != nil {
+		t.Fatal(err)
+	}
+	return v
+}
+
+func TestSearchMemoryRoundTrip(t *testing.T) {
+	client, path, body := newRecordingServer(t, `{"code":0,"request_id":"req-1","data":{"count":1,"result_list":[
+		{"id":"m1","memory_type":"preference","score":0.9,"user_id":["u1"],"session_id":"s1","content":{"food":"tea"},"time":1700000000000}]}}`)
+
+	resp, err := client.Collection("c", "p").SearchMemory(context.Background(), memory.SearchMemoryRequest{
+		Query:  "what does the user drink",
+		Limit:  5,
+		Filter: &memory.MemoryFilter{UserID: []string{"u1"}, MemoryType: []string{"preference"}, TimeRange: memory.TimeRange{StartTime: 1}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if *path != "/api/memory/search" {
+		t.Fatalf("path = %q", *path)
+	}
+	want := jsonValue(t, `{"query":"what does the user drink","limit":5,"collection_name":"c","project_name":"p",
+		"filter":{"user_id":["u1"],"memory_type":["preference"],"start_time":1}}`)
+	if !reflect.DeepEqual(*body, want) {
+		t.Fatalf("request = %v, want %v", *body, want)
+	}
+
+	wantResp := &memory.SearchMemoryResponse{
+		CommonResponse: memory.CommonResponse{RequestID: "req-1"},
+		Data: &memory.SearchMemoryResult{Count: 1, Items: []memory.MemoryItem{{
+			ID: "m1", MemoryType: "preference", Score: 0.9, UserID: []string{"u1"}, SessionID: "s1",
+			Content: map[string]interface{}{"food": "tea"}, Time: 1700000000000,
+		}}},
+	}
+	if !reflect.DeepEqual(resp, wantResp) {
+		t.Fatalf("response = %+v, want %+v", resp, wantResp)
+	}
+}
+
+func TestMessageMarshalJSON(t *testing.T) {
+	cases := []struct {
+		name    string
+		message memory.Message
+		want    string
+	}{
+		{
+			name:    "plain text",
+			message: memory.Message{Role: memory.RoleUser, Content: "hello"},
+			want:    `{"role":"user","content":"hello"}`,
+		},
+		{
+			name: "content parts",
+			message: memory.Message{Role: memory.RoleUser, Parts: []memory.ContentPart{
+				memory.TextPart("look at this"), memory.ImagePart("https://example.com/a.png"), memory.TextPart("and this"),
+			}},
+			want: `{"role":"user","content":"look at this\nand this","content_parts":[
+				{"type":"text","text":"look at this"},
+				{"type":"image_url","image_url":{"url":"https://example.com/a.png"}},
+				{"type":"text","text":"and this"}]}`,
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			raw, err := json.Marshal(tc.message)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got, want := jsonValue(t, string(raw)), jsonValue(t, tc.want); !reflect.DeepEqual(got, want) {
+				t.Fatalf("json = %s, want %s", raw, tc.want)
+			}
+		})
+	}
+}
+
+func TestNonZeroCodeIsAnError(t *testing.T) {
+	reply := `{"code":1000001,"message":"collection not found","request_id":"req-1"}`
+	cases := []struct {
+		name string
+		call func(*memory.Collection) error
+	}{
+		{name: "search", call: func(c *memory.Collection) error {
+			_, err := c.SearchMemory(context.Background(), memory.SearchMemoryRequest{Query: "q"})
+			return err
+		}},
+		{name: "add session", call: func(c *memory.Collection) error {
+			return c.AddSession(context.Background(), "s", []memory.Message{memory.NewMessage(memory.RoleUser, "hi")})
+		}},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			client, _, _ := newRecordingServer(t, reply)
+
+			err := tc.call(client.Collection("c", "p"))
+			var sdkErr *model.Error
+			if !errors.As(err, &sdkErr) {
+				t.Fatalf("err = %#v, want *model.Error", err)
+			}
+			if sdkErr.Code != "1000001" || sdkErr.StatusCode != http.StatusOK || sdkErr.Message != "collection not found" || sdkErr.RequestID != "req-1" {
+				t.Fatalf("err = %+v", sdkErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,90 @@
+// Copyright (c) 2025 Beijing Volcano Engine Technology Co., Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package memory
+
+import (
+	"context"
+	"net/http"
+)
+
+// ListSessionsRequest is the request for ListSessions.
+type ListSessionsRequest struct {
+	Filter         *MemoryFilter `json:"filter,omitempty"`
+	Offset         int           `json:"offset,omitempty"`
+	Limit          int           `json:"limit,omitempty"`
+	CollectionName string        `json:"collection_name"`
+	ProjectName    string        `json:"project_name"`
+	ResourceID     string        `json:"resource_id,omitempty"`
+}
+
+// ListSessionsResult holds a page of sessions.
+type ListSessionsResult struct {
+	Total    int       `json:"total"`
+	Sessions []Session `json:"session_list"`
+}
+
+// ListSessionsResponse is the response of ListSessions.
+type ListSessionsResponse struct {
+	CommonResponse
+	Data *ListSessionsResult `json:"data,omitempty"`
+}
+
+// GetSessionRequest is the request for GetSession.
+type GetSessionRequest struct {
+	SessionID      string `json:"session_id"`
+	CollectionName string `json:"collection_name"`
+	ProjectName    string `json:"project_name"`
+	ResourceID     string `json:"resource_id,omitempty"`
+}
+
+// GetSessionResponse is the response of GetSession.
+type GetSessionResponse struct {
+	CommonResponse
+	Data *Session `json:"data,omitempty"`
+}
+
+// DeleteSessionRequest is the request for DeleteSession.
+type DeleteSessionRequest struct {
+	SessionID      string `json:"session_id"`
+	CollectionName string `json:"collection_name"`
+	ProjectName    string `json:"project_name"`
+	ResourceID     string `json:"resource_id,omitempty"`
+}
+
+// DeleteSessionResponse is the response of DeleteSession.
+type DeleteSessionResponse struct {
+	CommonResponse
+}
+
+// ListSessions lists the sessions stored in the collection.
+func (c *Collection) ListSessions(ctx context.Context, request ListSessionsRequest, opts ...RequestOption) (*ListSessionsResponse, error) {
+	request.CollectionName, request.ProjectName, request.ResourceID = c.locator()
+	resp := &ListSessionsResponse{}
+	if err := c.client.doRequest(ctx, http.MethodPost, "/api/memory/session/list", request, resp, opts...); err != nil {
+		return resp, err
+	}
+	return resp, nil
+}
+
+// GetSession fetches a session with its messages.
+func (c *Collection) GetSession(ctx context.Context, sessionID string, opts ...RequestOption) (*GetSessionResponse, error) {
+	request := GetSessionRequest{SessionID: sessionID}
+	request.CollectionName, request.ProjectName, request.ResourceID = c.locator()
+	resp := &GetSessionResponse{}
+	if err := c.client.doRequest(ctx, http.MethodPost, "/api/memory/session/info", request, resp, opts...); err != nil {
+		return resp, err
+	}
+	return resp, nil
+}
+
+// DeleteSession removes a session from the collection.
+func (c *Collection) DeleteSession(ctx context.Context, sessionID string, opts ...RequestOption) (*DeleteSessionResponse, error) {
+	request := DeleteSessionRequest{SessionID: sessionID}
+	request.CollectionName, request.ProjectName, request.ResourceID = c.locator()
+	resp := &DeleteSessionResponse{}
+	if err := c.client.doRequest(ctx, http.MethodPost, "/api/memory/session/delete", request, resp, opts...); err != nil {
+		return resp, err
+	}
+	return resp, nil
+}
@@ -0,0 +1,76 @@
+// Copyright (c) 2025 Beijing Volcano Engine Technology Co., Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package memory
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/volcengine/vikingdb-go-sdk/vector/model"
+)
+
+// CommonResponse represents the shared response envelope returned by the memory APIs. Code 0 means success.
+type CommonResponse struct {
+	Code      int    `json:"code"`
+	Message   string `json:"message,omitempty"`
+	RequestID string `json:"request_id,omitempty"`
+}
+
+func (r *CommonResponse) common() *CommonResponse {
+	return r
+}
+
+// err returns the failure reported by a non-zero Code.
+func (r *CommonResponse) err() error {
+	if r.Code == 0 {
+		return nil
+	}
+	return model.NewErrorWithRequestID(model.ErrorCode(strconv.Itoa(r.Code)), r.Message, r.RequestID, http.StatusOK)
+}
+
+// TimeRange bounds a query by time, in Unix milliseconds. Zero leaves the side open.
+type TimeRange struct {
+	StartTime int64 `json:"start_time,omitempty"`
+	EndTime   int64 `json:"end_time,omitempty"`
+}
+
+// MemoryFilter narrows memory, session and event queries.
+type MemoryFilter struct {
+	UserID      []string `json:"user_id,omitempty"`
+	AssistantID []string `json:"assistant_id,omitempty"`
+	// MemoryType restricts results to the listed event or profile types.
+	MemoryType []string `json:"memory_type,omitempty"`
+	TimeRange
+}
+
+// Event is a fact extracted from sessions, such as a preference or a plan.
+type Event struct {
+	EventID     string                 `json:"event_id"`
+	EventType   string                 `json:"event_type"`
+	SessionID   string                 `json:"session_id,omitempty"`
+	UserID      []string               `json:"user_id,omitempty"`
+	AssistantID []string               `json:"assistant_id,omitempty"`
+	Content     map[string]interface{} `json:"content,omitempty"`
+	Time        int64                  `json:"time,omitempty"`
+	UpdateTime  int64                  `json:"update_time,omitempty"`
+}
+
+// Profile is the long-lived state accumulated for a user, keyed by profile type.
+type Profile struct {
+	ProfileID   string                 `json:"profile_id,omitempty"`
+	ProfileType string                 `json:"profile_type"`
+	UserID      []string               `json:"user_id,omitempty"`
+	AssistantID []string               `json:"assistant_id,omitempty"`
+	Content     map[string]interface{} `json:"content,omitempty"`
+	UpdateTime  int64                  `json:"update_time,omitempty"`
+}
+
+// Session is a stored conversation.
+type Session struct {
+	SessionID  string                 `json:"session_id"`
+	Messages   []Message              `json:"messages,omitempty"`
+	Metadata   map[string]interface{} `json:"metadata,omitempty"`
+	CreateTime int64                  `json:"create_time,omitempty"`
+	UpdateTime int64                  `json:"update_time,omitempty"`
+}
@@ -0,0 +1,308 @@
+// Copyright (c) 2025 Beijing Volcano Engine Technology Co., Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package vector
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/volcengine/vikingdb-go-sdk/vector/model"
+	"github.com/volcengine/vikingdb-go-sdk/vector/utils"
+)
+
+const (
+	defaultBulkMaxBatchItems = 100
+	defaultBulkMaxBatchBytes = 4 << 20
+	defaultBulkConcurrency   = 4
+)
+
+// BulkWriterConfig controls how a BulkWriter groups and sends documents.
+type BulkWriterConfig struct {
+	// MaxBatchItems flushes a batch once it holds this many documents.
+	MaxBatchItems int
+	// MaxBatchBytes flushes a batch before its JSON payload would exceed this size.
+	MaxBatchBytes int
+	// Concurrency bounds the number of Upsert calls in flight.
+	Concurrency int
+
+	TTL                 *int32
+	IgnoreUnknownFields bool
+	Async               bool
+
+	// RequestOptions are applied to every Upsert call.
+	RequestOptions []RequestOption
+	// OnBatch, when set, is invoked after every batch completes. It may be called concurrently.
+	OnBatch func(BatchResult)
+}
+
+// BatchResult reports the outcome of a single Upsert batch.
+type BatchResult struct {
+	// Seq numbers batches in the order they were cut from the buffer, starting at 1.
+	Seq      int
+	Items    []model.MapStr
+	Response *model.UpsertDataResponse
+	Err      error
+	Duration time.Duration
+}
+
+// BulkSummary aggregates the outcome of every batch sent by a BulkWriter.
+type BulkSummary struct {
+	Batches       int
+	FailedBatches int
+	Items         int
+	FailedItems   int
+	TokenUsage    model.MapStr
+}
+
+// BulkWriter batches documents and upserts them concurrently through a CollectionClient.
+//
+// Batches are sent under a context owned by the writer, so cancelling the context passed to Add or
+// Flush only stops that call from waiting; batches already in flight keep running until they finish
+// or Close gives up on them.
+type BulkWriter struct {
+	collection CollectionClient
+	config     BulkWriterConfig
+	slots      chan struct{}
+	ctx        context.Context
+	cancel     context.CancelFunc
+
+	mu       sync.Mutex
+	batch    []model.MapStr
+	bytes    int
+	seq      int
+	closed   bool
+	summary  BulkSummary
+	firstErr error
+	// inflight counts batches taken from the buffer that have not been recorded yet; idle is
+	// closed when it drops back to zero.
+	inflight int
+	idle     chan struct{}
+}
+
+// NewBulkWriter constructs a BulkWriter writing into collection.
+func NewBulkWriter(collection CollectionClient, config BulkWriterConfig) *BulkWriter {
+	if config.MaxBatchItems <= 0 {
+		config.MaxBatchItems = defaultBulkMaxBatchItems
+	}
+	if config.MaxBatchBytes <= 0 {
+		config.MaxBatchBytes = defaultBulkMaxBatchBytes
+	}
+	if config.Concurrency <= 0 {
+		config.Concurrency = defaultBulkConcurrency
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	return &BulkWriter{
+		collection: collection,
+		config:     config,
+		slots:      make(chan struct{}, config.Concurrency),
+		ctx:        ctx,
+		cancel:     cancel,
+	}
+}
+
+// Add queues a document, sending the pending batch when it reaches the item or byte limit.
+// Add blocks while Concurrency batches are already in flight; ctx bounds that wait.
+func (w *BulkWriter) Add(ctx context.Context, item model.MapStr) error {
+	encoded, err := utils.SerializeToJSON(item)
+	if err != nil {
+		return model.NewErrorWithCause(model.ErrCodeInvalidParameter, "failed to marshal document", err, http.StatusBadRequest)
+	}
+	size := len(encoded) + 1
+
+	w.mu.Lock()
+	if w.closed {
+		w.mu.Unlock()
+		return model.NewInvalidParameterError("bulk writer is closed")
+	}
+	var full []model.MapStr
+	var seq int
+	if len(w.batch) > 0 && w.bytes+size > w.config.MaxBatchBytes {
+		full, seq = w.takeBatchLocked()
+	}
+	w.batch = append(w.batch, item)
+	w.bytes += size
+	w.mu.Unlock()
+
+	if full != nil {
+		if err := w.dispatch(ctx, full, seq); err != nil {
+			return err
+		}
+	}
+
+	w.mu.Lock()
+	if len(w.batch) >= w.config.MaxBatchItems {
+		full, seq = w.takeBatchLocked()
+	} else {
+		full = nil
+	}
+	w.mu.Unlock()
+	if full != nil {
+		return w.dispatch(ctx, full, seq)
+	}
+	return nil
+}
+
+// AddFrom queues every document received from items until the channel is closed or ctx is done.
+func (w *BulkWriter) AddFrom(ctx context.Context, items <-chan model.MapStr) error {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case item, ok := <-items:
+			if !ok {
+				return nil
+			}
+			if err := w.Add(ctx, item); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// Flush sends the pending batch and waits for every in-flight batch to finish. When ctx is done
+// first, Flush returns its error and the batches keep running in the background.
+func (w *BulkWriter) Flush(ctx context.Context) error {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	w.mu.Lock()
+	pending, seq := w.takeBatchLocked()
+	w.mu.Unlock()
+	if pending != nil {
+		if err := w.dispatch(ctx, pending, seq); err != nil {
+			return err
+		}
+	}
+	return w.wait(ctx)
+}
+
+// Close stops accepting documents, flushes outstanding ones and returns the aggregated summary. The
+// returned error is non-nil when at least one batch failed; it wraps the first batch failure. When
+// ctx is done before the batches finish, the batches still in flight are cancelled.
+func (w *BulkWriter) Close(ctx context.Context) (*BulkSummary, error) {
+	w.mu.Lock()
+	w.closed = true
+	w.mu.Unlock()
+
+	flushErr := w.Flush(ctx)
+	// Abort batches still running after ctx expired and let them record their failure.
+	w.cancel()
+	if flushErr != nil {
+		_ = w.wait(context.Background())
+	}
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	summary := w.summary
+	if flushErr != nil {
+		return &summary, flushErr
+	}
+	if summary.FailedBatches > 0 {
+		return &summary, model.NewErrorWithCause(model.ErrCodeDataInsertFailed,
+			fmt.Sprintf("%d of %d batches failed", summary.FailedBatches, summary.Batches), w.firstErr, http.StatusInternalServerError)
+	}
+	return &summary, nil
+}
+
+// Summary returns a snapshot of the results gathered so far.
+func (w *BulkWriter) Summary() BulkSummary {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return w.summary
+}
+
+// takeBatchLocked cuts the pending batch and numbers it.
+func (w *BulkWriter) takeBatchLocked() ([]model.MapStr, int) {
+	if len(w.batch) == 0 {
+		return nil, 0
+	}
+	batch := w.batch
+	w.batch = nil
+	w.bytes = 0
+	w.seq++
+	// The batch counts as in flight from here so a concurrent Flush waits for it.
+	w.inflight++
+	if w.idle == nil {
+		w.idle = make(chan struct{})
+	}
+	return batch, w.seq
+}
+
+// wait blocks until no batch is in flight or ctx is done.
+func (w *BulkWriter) wait(ctx context.Context) error {
+	w.mu.Lock()
+	idle := w.idle
+	w.mu.Unlock()
+	if idle == nil {
+		return nil
+	}
+	select {
+	case <-idle:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (w *BulkWriter) dispatch(ctx context.Context, batch []model.MapStr, seq int) error {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	select {
+	case w.slots <- struct{}{}:
+	case <-ctx.Done():
+		w.record(BatchResult{Seq: seq, Items: batch, Err: ctx.Err()})
+		return ctx.Err()
+	}
+
+	go func() {
+		defer func() { <-w.slots }()
+
+		start := time.Now()
+		resp, err := w.collection.Upsert(w.ctx, model.UpsertDataRequest{
+			WriteDataBase: model.WriteDataBase{
+				Data:                batch,
+				TTL:                 w.config.TTL,
+				IgnoreUnknownFields: w.config.IgnoreUnknownFields,
+			},
+			Async: w.config.Async,
+		}, w.config.RequestOptions...)
+		w.record(BatchResult{Seq: seq, Items: batch, Response: resp, Err: err, Duration: time.Since(start)})
+	}()
+	return nil
+}
+
+func (w *BulkWriter) record(result BatchResult) {
+	w.mu.Lock()
+	w.summary.Batches++
+	w.summary.Items += len(result.Items)
+	if result.Err != nil {
+		w.summary.FailedBatches++
+		w.summary.FailedItems += len(result.Items)
+		if w.firstErr == nil {
+			w.firstErr = result.Err
+		}
+	} else if result.Response != nil && result.Response.Result != nil {
+		w.summary.TokenUsage = mergeTokenUsage(w.summary.TokenUsage, result.Response.Result.TokenUsage)
+	}
+	w.mu.Unlock()
+
+	if w.config.OnBatch != nil {
+		w.config.OnBatch(result)
+	}
+
+	w.mu.Lock()
+	w.inflight--
+	if w.inflight == 0 {
+		close(w.idle)
+		w.idle = nil
+	}
+	w.mu.Unlock()
+}
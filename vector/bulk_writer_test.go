@@ -0,0 +1,271 @@
+// Copyright (c) 2025 Beijing Volcano Engine Technology Co., Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package vector
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/volcengine/vikingdb-go-sdk/vector/model"
+)
+
+// stubCollection is a CollectionClient whose Upsert delegates to upsert and counts the documents it saw.
+type stubCollection struct {
+	upsert func(ctx context.Context, request model.UpsertDataRequest) (*model.UpsertDataResponse, error)
+	items  int64
+}
+
+func (s *stubCollection) Upsert(ctx context.Context, request model.UpsertDataRequest, opts ...RequestOption) (*model.UpsertDataResponse, error) {
+	atomic.AddInt64(&s.items, int64(len(request.Data)))
+	if s.upsert != nil {
+		return s.upsert(ctx, request)
+	}
+	return &model.UpsertDataResponse{}, nil
+}
+
+func (s *stubCollection) Update(context.Context, model.UpdateDataRequest, ...RequestOption) (*model.UpdateDataResponse, error) {
+	return &model.UpdateDataResponse{}, nil
+}
+
+func (s *stubCollection) Delete(context.Context, model.DeleteDataRequest, ...RequestOption) (*model.DeleteDataResponse, error) {
+	return &model.DeleteDataResponse{}, nil
+}
+
+func (s *stubCollection) Fetch(context.Context, model.FetchDataInCollectionRequest, ...RequestOption) (*model.FetchDataInCollectionResponse, error) {
+	return &model.FetchDataInCollectionResponse{}, nil
+}
+
+func (s *stubCollection) CollectionName() string { return "stub" }
+func (s *stubCollection) ResourceID() string     { return "" }
+func (s *stubCollection) ProjectName() string    { return "" }
+
+func TestBulkWriterBatching(t *testing.T) {
+	cases := []struct {
+		name        string
+		config      BulkWriterConfig
+		items       int
+		wantBatches int
+	}{
+		{name: "item limit", config: BulkWriterConfig{MaxBatchItems: 10}, items: 25, wantBatches: 3},
+		{name: "exact item limit", config: BulkWriterConfig{MaxBatchItems: 5}, items: 20, wantBatches: 4},
+		// Each document encodes to {"id":N} plus a newline, i.e. 9 bytes for single digits.
+		{name: "byte limit", config: BulkWriterConfig{MaxBatchBytes: 20}, items: 6, wantBatches: 3},
+		{name: "nothing queued", config: BulkWriterConfig{}, items: 0, wantBatches: 0},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			stub := &stubCollection{}
+			w := NewBulkWriter(stub, tc.config)
+			for i := 0; i < tc.items; i++ {
+				if err := w.Add(context.Background(), model.MapStr{"id": i}); err != nil {
+					t.Fatalf("Add: %v", err)
+				}
+			}
+			summary, err := w.Close(context.Background())
+			if err != nil {
+				t.Fatalf("Close: %v", err)
+			}
+			if summary.Batches != tc.wantBatches || summary.Items != tc.items || summary.FailedBatches != 0 {
+				t.Fatalf("summary = %+v, want %d batches of %d items", summary, tc.wantBatches, tc.items)
+			}
+			if got := atomic.LoadInt64(&stub.items); got != int64(tc.items) {
+				t.Fatalf("upserted %d items, want %d", got, tc.items)
+			}
+		})
+	}
+}
+
+func TestBulkWriterConcurrentAdds(t *testing.T) {
+	stub := &stubCollection{upsert: func(ctx context.Context, request model.UpsertDataRequest) (*model.UpsertDataResponse, error) {
+		time.Sleep(time.Millisecond)
+		return &model.UpsertDataResponse{}, nil
+	}}
+	w := NewBulkWriter(stub, BulkWriterConfig{MaxBatchItems: 7, Concurrency: 3})
+
+	const workers, perWorker = 8, 50
+	var wg sync.WaitGroup
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func(worker int) {
+			defer wg.Done()
+			for j := 0; j < perWorker; j++ {
+				if err := w.Add(context.Background(), model.MapStr{"id": worker*perWorker + j}); err != nil {
+					t.Errorf("Add: %v", err)
+					return
+				}
+				if j%20 == 0 {
+					if err := w.Flush(context.Background()); err != nil {
+						t.Errorf("Flush: %v", err)
+						return
+					}
+				}
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	summary, err := w.Close(context.Background())
+	if err != nil {
+		t.Fatalf("Close: %v", err)
+	}
+	if summary.Items != workers*perWorker || atomic.LoadInt64(&stub.items) != workers*perWorker {
+		t.Fatalf("summary = %+v, upserted %d", summary, atomic.LoadInt64(&stub.items))
+	}
+}
+
+func TestBulkWriterAddRacingClose(t *testing.T) {
+	stub := &stubCollection{}
+	w := NewBulkWriter(stub, BulkWriterConfig{MaxBatchItems: 3, Concurrency: 2})
+
+	var accepted int64
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 200; j++ {
+				if err := w.Add(context.Background(), model.MapStr{"id": j}); err != nil {
+					return
+				}
+				atomic.AddInt64(&accepted, 1)
+			}
+		}()
+	}
+	time.Sleep(time.Millisecond)
+	summary, err := w.Close(context.Background())
+	if err != nil {
+		t.Fatalf("Close: %v", err)
+	}
+	wg.Wait()
+
+	// Every document Add accepted must be part of the final summary: nothing may slip in after Close.
+	if int64(summary.Items) != atomic.LoadInt64(&accepted) {
+		t.Fatalf("summary has %d items, Add accepted %d", summary.Items, atomic.LoadInt64(&accepted))
+	}
+	if got := atomic.LoadInt64(&stub.items); got != atomic.LoadInt64(&accepted) {
+		t.Fatalf("upserted %d items, Add accepted %d", got, atomic.LoadInt64(&accepted))
+	}
+	if err := w.Add(context.Background(), model.MapStr{"id": 0}); err == nil {
+		t.Fatal("Add succeeded after Close")
+	}
+}
+
+func TestBulkWriterBatchOutlivesAddContext(t *testing.T) {
+	release := make(chan struct{})
+	stub := &stubCollection{upsert: func(ctx context.Context, request model.UpsertDataRequest) (*model.UpsertDataResponse, error) {
+		<-release
+		return &model.UpsertDataResponse{}, ctx.Err()
+	}}
+	w := NewBulkWriter(stub, BulkWriterConfig{MaxBatchItems: 1})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	if err := w.Add(ctx, model.MapStr{"id": 1}); err != nil {
+		t.Fatalf("Add: %v", err)
+	}
+	cancel()
+	close(release)
+
+	summary, err := w.Close(context.Background())
+	if err != nil {
+		t.Fatalf("batch was aborted by the Add context: %v", err)
+	}
+	if summary.Batches != 1 || summary.FailedBatches != 0 {
+		t.Fatalf("summary = %+v", summary)
+	}
+}
+
+func TestBulkWriterCloseDeadlineCancelsInFlight(t *testing.T) {
+	stub := &stubCollection{upsert: func(ctx context.Context, request model.UpsertDataRequest) (*model.UpsertDataResponse, error) {
+		<-ctx.Done()
+		return nil, ctx.Err()
+	}}
+	w := NewBulkWriter(stub, BulkWriterConfig{MaxBatchItems: 1})
+	if err := w.Add(context.Background(), model.MapStr{"id": 1}); err != nil {
+		t.Fatalf("Add: %v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	summary, err := w.Close(ctx)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("Close err = %v, want deadline exceeded", err)
+	}
+	if summary.Batches != 1 || summary.FailedBatches != 1 {
+		t.Fatalf("summary = %+v, want the cancelled batch recorded as failed", summary)
+	}
+}
+
+func TestBulkWriterReportsFailures(t *testing.T) {
+	boom := errors.New("boom")
+	var n int64
+	var results int64
+	stub := &stubCollection{upsert: func(ctx context.Context, request model.UpsertDataRequest) (*model.UpsertDataResponse, error) {
+		if atomic.AddInt64(&n, 1) == 2 {
+			return nil, boom
+		}
+		return &model.UpsertDataResponse{}, nil
+	}}
+	w := NewBulkWriter(stub, BulkWriterConfig{
+		MaxBatchItems: 2,
+		Concurrency:   1,
+		OnBatch:       func(BatchResult) { atomic.AddInt64(&results, 1) },
+	})
+	for i := 0; i < 6; i++ {
+		if err := w.Add(context.Background(), model.MapStr{"id": i}); err != nil {
+			t.Fatalf("Add: %v", err)
+		}
+	}
+	summary, err := w.Close(context.Background())
+	if !errors.Is(err, boom) {
+		t.Fatalf("Close err = %v, want it to wrap the batch failure", err)
+	}
+	if summary.Batches != 3 || summary.FailedBatches != 1 || summary.FailedItems != 2 {
+		t.Fatalf("summary = %+v", summary)
+	}
+	if atomic.LoadInt64(&results) != 3 {
+		t.Fatalf("OnBatch called %d times, want 3", results)
+	}
+}
+
+func TestBulkWriterNumbersBatchesWhenCut(t *testing.T) {
+	release := make(chan struct{})
+	stub := &stubCollection{upsert: func(ctx context.Context, request model.UpsertDataRequest) (*model.UpsertDataResponse, error) {
+		<-release
+		return &model.UpsertDataResponse{}, nil
+	}}
+	var mu sync.Mutex
+	seqs := map[int]error{}
+	w := NewBulkWriter(stub, BulkWriterConfig{
+		MaxBatchItems: 1,
+		Concurrency:   1,
+		OnBatch: func(result BatchResult) {
+			mu.Lock()
+			seqs[result.Seq] = result.Err
+			mu.Unlock()
+		},
+	})
+	if err := w.Add(context.Background(), model.MapStr{"id": 1}); err != nil {
+		t.Fatalf("Add: %v", err)
+	}
+	// The only slot is taken, so the second batch fails waiting for one.
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if err := w.Add(ctx, model.MapStr{"id": 2}); !errors.Is(err, context.Canceled) {
+		t.Fatalf("Add err = %v, want context canceled", err)
+	}
+	close(release)
+	if _, err := w.Close(context.Background()); err == nil {
+		t.Fatal("Close reported no failed batch")
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	if len(seqs) != 2 || seqs[1] != nil || !errors.Is(seqs[2], context.Canceled) {
+		t.Fatalf("batch results by seq = %v, want 1 succeeded and 2 cancelled", seqs)
+	}
+}
@@ -0,0 +1,262 @@
+// Copyright (c) 2025 Beijing Volcano Engine Technology Co., Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package vector
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"sync"
+
+	"github.com/volcengine/vikingdb-go-sdk/vector/model"
+	"github.com/volcengine/vikingdb-go-sdk/vector/utils"
+)
+
+// CassetteMode selects whether a Cassette talks to the network.
+type CassetteMode int
+
+const (
+	// CassetteReplay serves recorded responses and never reaches the network. Unmatched requests fail
+	// with a NotFound error.
+	CassetteReplay CassetteMode = iota
+	// CassetteRecord sends every request and records it, replacing the previous content of the file.
+	CassetteRecord
+	// CassetteAuto replays when the cassette file exists and records otherwise.
+	CassetteAuto
+)
+
+// Interaction is one recorded request/response pair. Request headers are never recorded, so signatures,
+// X-Date and API keys stay out of the file.
+type Interaction struct {
+	Request  RecordedRequest  `json:"request"`
+	Response RecordedResponse `json:"response"`
+}
+
+// RecordedRequest identifies a request by method, path, query and normalized JSON body.
+type RecordedRequest struct {
+	Method string          `json:"method"`
+	Path   string          `json:"path"`
+	Query  string          `json:"query,omitempty"`
+	Body   json.RawMessage `json:"body,omitempty"`
+}
+
+// RecordedResponse is the reply served back during replay.
+type RecordedResponse struct {
+	StatusCode int                 `json:"status_code"`
+	Header     map[string][]string `json:"header,omitempty"`
+	Body       string              `json:"body"`
+}
+
+type cassetteFile struct {
+	Interactions []Interaction `json:"interactions"`
+}
+
+// Cassette records the HTTP exchanges of a client into a JSON file and replays them offline. Identical
+// requests are replayed in the order they were recorded; once exhausted the last match is served again.
+type Cassette struct {
+	path      string
+	recording bool
+
+	mu           sync.Mutex
+	interactions []Interaction
+	used         []bool
+}
+
+// NewCassette opens the cassette stored at path. Replay mode requires the file to exist.
+func NewCassette(path string, mode CassetteMode) (*Cassette, error) {
+	c := &Cassette{path: path}
+	switch mode {
+	case CassetteRecord:
+		c.recording = true
+		return c, nil
+	case CassetteAuto:
+		if _, err := os.Stat(path); os.IsNotExist(err) {
+			c.recording = true
+			return c, nil
+		}
+	}
+
+	raw, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, model.NewErrorWithCause(model.ErrCodeInvalidParameter, "failed to read cassette", err, http.StatusBadRequest)
+	}
+	var file cassetteFile
+	if err := json.Unmarshal(raw, &file); err != nil {
+		return nil, model.NewErrorWithCause(model.ErrCodeInvalidParameter, "failed to parse cassette", err, http.StatusBadRequest)
+	}
+	for i := range file.Interactions {
+		// The file is indented, so bodies are normalized again before matching.
+		file.Interactions[i].Request.Body = normalizeJSON(file.Interactions[i].Request.Body)
+	}
+	c.interactions = file.Interactions
+	c.used = make([]bool, len(file.Interactions))
+	return c, nil
+}
+
+// Recording reports whether the cassette sends requests to the network.
+func (c *Cassette) Recording() bool {
+	return c.recording
+}
+
+// Interactions returns a copy of the recorded interactions.
+func (c *Cassette) Interactions() []Interaction {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return append([]Interaction(nil), c.interactions...)
+}
+
+// WithCassette routes every HTTP request of the client through the cassette, recording or replaying it.
+// It composes with WithHTTPClient regardless of option order.
+func WithCassette(cassette *Cassette) ClientOption {
+	return func(c *Config) {
+		c.Cassette = cassette
+	}
+}
+
+// wrap returns a copy of client whose requests go through the cassette.
+func (c *Cassette) wrap(client *http.Client) *http.Client {
+	wrapped := *client
+	next := client.Transport
+	if next == nil {
+		next = http.DefaultTransport
+	}
+	wrapped.Transport = &cassetteTransport{cassette: c, next: next}
+	return &wrapped
+}
+
+type cassetteTransport struct {
+	cassette *Cassette
+	next     http.RoundTripper
+}
+
+func (t *cassetteTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	key, err := recordRequest(req)
+	if err != nil {
+		return nil, err
+	}
+	if !t.cassette.recording {
+		return t.cassette.replay(req, key), nil
+	}
+
+	resp, err := t.next.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	body, err := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = ioutil.NopCloser(bytes.NewReader(body))
+
+	header := make(map[string][]string, len(resp.Header))
+	for k, v := range resp.Header {
+		header[k] = append([]string(nil), v...)
+	}
+	if err := t.cassette.record(Interaction{
+		Request:  key,
+		Response: RecordedResponse{StatusCode: resp.StatusCode, Header: header, Body: string(body)},
+	}); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// recordRequest reads the request body, restoring it for the actual send, and builds the match key.
+func recordRequest(req *http.Request) (RecordedRequest, error) {
+	key := RecordedRequest{Method: req.Method, Path: req.URL.Path, Query: req.URL.Query().Encode()}
+	if req.Body == nil || req.Body == http.NoBody {
+		return key, nil
+	}
+	body, err := ioutil.ReadAll(req.Body)
+	req.Body.Close()
+	if err != nil {
+		return key, err
+	}
+	req.Body = ioutil.NopCloser(bytes.NewReader(body))
+	key.Body = normalizeJSON(body)
+	return key, nil
+}
+
+// normalizeJSON re-encodes body with sorted keys and compact spacing; non-JSON bodies are kept as strings.
+func normalizeJSON(body []byte) json.RawMessage {
+	if len(bytes.TrimSpace(body)) == 0 {
+		return nil
+	}
+	var value interface{}
+	if err := utils.ParseJSONUseNumber(body, &value); err != nil {
+		quoted, _ := json.Marshal(string(body))
+		return quoted
+	}
+	normalized, err := json.Marshal(value)
+	if err != nil {
+		return json.RawMessage(body)
+	}
+	return normalized
+}
+
+func (c *Cassette) record(interaction Interaction) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.interactions = append(c.interactions, interaction)
+	c.used = append(c.used, true)
+
+	raw, err := json.MarshalIndent(cassetteFile{Interactions: c.interactions}, "", "  ")
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(c.path, raw, 0600)
+}
+
+func (c *Cassette) replay(req *http.Request, key RecordedRequest) *http.Response {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	match := -1
+	for i, interaction := range c.interactions {
+		if !sameRequest(interaction.Request, key) {
+			continue
+		}
+		if !c.used[i] {
+			match = i
+			break
+		}
+		match = i
+	}
+	if match < 0 {
+		body, _ := json.Marshal(map[string]string{
+			"code":    string(model.ErrCodeNotFound),
+			"message": fmt.Sprintf("cassette: no recorded interaction for %s %s", key.Method, key.Path),
+		})
+		return cassetteResponse(req, http.StatusNotFound, nil, string(body))
+	}
+	c.used[match] = true
+	recorded := c.interactions[match].Response
+	return cassetteResponse(req, recorded.StatusCode, recorded.Header, recorded.Body)
+}
+
+func sameRequest(a, b RecordedRequest) bool {
+	return a.Method == b.Method && a.Path == b.Path && a.Query == b.Query && bytes.Equal(a.Body, b.Body)
+}
+
+func cassetteResponse(req *http.Request, status int, header map[string][]string, body string) *http.Response {
+	h := make(http.Header, len(header))
+	for k, v := range header {
+		h[k] = append([]string(nil), v...)
+	}
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", status, http.StatusText(status)),
+		StatusCode:    status,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        h,
+		Body:          ioutil.NopCloser(bytes.NewReader([]byte(body))),
+		ContentLength: int64(len(body)),
+		Request:       req,
+	}
+}
@@ -0,0 +1,128 @@
+// Copyright (c) 2025 Beijing Volcano Engine Technology Co., Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package vector
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/volcengine/vikingdb-go-sdk/vector/model"
+)
+
+func TestCassetteRecordAndReplay(t *testing.T) {
+	var hits int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := atomic.AddInt32(&hits, 1)
+		_, _ = io.Copy(ioutil.Discard, r.Body)
+		_, _ = fmt.Fprintf(w, `{"code":"Success","request_id":"req","result":{"data":[{"id":%d}]}}`, n)
+	}))
+	defer srv.Close()
+	path := filepath.Join(t.TempDir(), "cassette.json")
+	index := func(mode CassetteMode) IndexClient {
+		cassette, err := NewCassette(path, mode)
+		if err != nil {
+			t.Fatal(err)
+		}
+		client, err := New(AuthAPIKey("secret-key"), WithEndpoint(srv.URL), WithCassette(cassette), WithMaxRetries(0))
+		if err != nil {
+			t.Fatal(err)
+		}
+		return client.Index(model.IndexLocator{CollectionLocator: model.CollectionLocator{CollectionName: "c"}, IndexName: "i"})
+	}
+	search := func(index IndexClient, query []float64) (interface{}, error) {
+		resp, err := index.SearchByVector(context.Background(), model.SearchByVectorRequest{DenseVector: query})
+		if err != nil {
+			return nil, err
+		}
+		return resp.Result.Data[0].ID, nil
+	}
+
+	// Auto records when the file is missing.
+	recorder := index(CassetteAuto)
+	for _, query := range [][]float64{{1}, {1}, {2}} {
+		if _, err := search(recorder, query); err != nil {
+			t.Fatal(err)
+		}
+	}
+	raw, err := ioutil.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(string(raw), "secret-key") {
+		t.Fatal("the cassette recorded the API key")
+	}
+
+	recorded := atomic.LoadInt32(&hits)
+	replayer := index(CassetteAuto)
+	cases := []struct {
+		name     string
+		query    []float64
+		wantID   string
+		wantCode model.ErrorCode
+	}{
+		{name: "identical requests replay in recorded order", query: []float64{1}, wantID: "1"},
+		{name: "second identical request", query: []float64{1}, wantID: "2"},
+		{name: "exhausted matches serve the last one again", query: []float64{1}, wantID: "2"},
+		{name: "different body", query: []float64{2}, wantID: "3"},
+		{name: "unrecorded request", query: []float64{3}, wantCode: model.ErrCodeNotFound},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			id, err := search(replayer, tc.query)
+			if tc.wantCode != "" {
+				var sdkErr *model.Error
+				if !errors.As(err, &sdkErr) || sdkErr.Code != tc.wantCode {
+					t.Fatalf("err = %v, want code %s", err, tc.wantCode)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := fmt.Sprint(id); got != tc.wantID {
+				t.Fatalf("id = %s, want %s", got, tc.wantID)
+			}
+		})
+	}
+	if hits := atomic.LoadInt32(&hits); hits != recorded {
+		t.Fatalf("replay reached the server %d times", hits-recorded)
+	}
+}
+
+func TestCassetteMatchesNormalizedBodies(t *testing.T) {
+	cases := []struct {
+		name string
+		a, b string
+		same bool
+	}{
+		{name: "key order and spacing", a: `{"a":1,"b":[1,2]}`, b: "{ \"b\": [1, 2],\n \"a\": 1 }", same: true},
+		{name: "large integers keep precision", a: `{"id":9007199254740993}`, b: `{"id":9007199254740992}`, same: false},
+		{name: "different values", a: `{"a":1}`, b: `{"a":2}`, same: false},
+		{name: "non-JSON bodies compare as text", a: "plain", b: "plain", same: true},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			a := RecordedRequest{Method: http.MethodPost, Path: "/p", Body: normalizeJSON([]byte(tc.a))}
+			b := RecordedRequest{Method: http.MethodPost, Path: "/p", Body: normalizeJSON([]byte(tc.b))}
+			if got := sameRequest(a, b); got != tc.same {
+				t.Fatalf("sameRequest = %v, want %v (%s vs %s)", got, tc.same, a.Body, b.Body)
+			}
+		})
+	}
+}
+
+func TestCassetteReplayRequiresFile(t *testing.T) {
+	if _, err := NewCassette(filepath.Join(t.TempDir(), "missing.json"), CassetteReplay); err == nil {
+		t.Fatal("expected an error for a missing cassette in replay mode")
+	}
+}
@@ -0,0 +1,222 @@
+// Copyright (c) 2025 Beijing Volcano Engine Technology Co., Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package vector
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/volcengine/vikingdb-go-sdk/vector/model"
+)
+
+// CircuitState is the state of a circuit breaker.
+type CircuitState int
+
+const (
+	// CircuitClosed lets every request through while failures are counted.
+	CircuitClosed CircuitState = iota
+	// CircuitOpen rejects requests immediately with ErrCodeCircuitOpen.
+	CircuitOpen
+	// CircuitHalfOpen lets a limited number of trial requests through to probe recovery.
+	CircuitHalfOpen
+)
+
+func (s CircuitState) String() string {
+	switch s {
+	case CircuitClosed:
+		return "closed"
+	case CircuitOpen:
+		return "open"
+	case CircuitHalfOpen:
+		return "half-open"
+	}
+	return fmt.Sprintf("CircuitState(%d)", int(s))
+}
+
+// CircuitBreakerConfig tunes the circuit breakers installed by WithCircuitBreaker.
+// Zero fields fall back to the defaults noted on each field.
+type CircuitBreakerConfig struct {
+	// Window is the period over which the failure ratio is measured (default 10s).
+	Window time.Duration
+	// MinRequests is the number of requests a window needs before the breaker may trip (default 20).
+	MinRequests int
+	// FailureRatio trips the breaker once this share of a window's requests failed (default 0.5).
+	FailureRatio float64
+	// OpenTimeout is how long the breaker stays open before probing again (default 30s).
+	OpenTimeout time.Duration
+	// HalfOpenMaxRequests is the number of successful probes needed to close again (default 1).
+	HalfOpenMaxRequests int
+	// OnStateChange, when set, is called after every transition.
+	OnStateChange func(family EndpointFamily, from, to CircuitState)
+}
+
+// WithCircuitBreaker installs a circuit breaker per endpoint family. Failures are the errors
+// model.IsRetryableError accepts; while a breaker is open, requests fail fast with ErrCodeCircuitOpen.
+func WithCircuitBreaker(config CircuitBreakerConfig) ClientOption {
+	return func(c *Config) {
+		c.CircuitBreaker = &config
+	}
+}
+
+func (c CircuitBreakerConfig) withDefaults() CircuitBreakerConfig {
+	if c.Window <= 0 {
+		c.Window = 10 * time.Second
+	}
+	if c.MinRequests <= 0 {
+		c.MinRequests = 20
+	}
+	if c.FailureRatio <= 0 || c.FailureRatio > 1 {
+		c.FailureRatio = 0.5
+	}
+	if c.OpenTimeout <= 0 {
+		c.OpenTimeout = 30 * time.Second
+	}
+	if c.HalfOpenMaxRequests <= 0 {
+		c.HalfOpenMaxRequests = 1
+	}
+	return c
+}
+
+type circuitBreaker struct {
+	family EndpointFamily
+	config CircuitBreakerConfig
+
+	mu          sync.Mutex
+	state       CircuitState
+	windowStart time.Time
+	requests    int
+	failures    int
+	openedAt    time.Time
+	probes      int
+	successes   int
+	// generation changes on every transition. Requests are tagged with the generation that admitted
+	// them so completions from an earlier state cannot count as probes of the current one.
+	generation uint64
+}
+
+// allow reports whether a request may proceed, moving an expired open breaker to half-open. It returns
+// the generation the request was admitted under, to be passed back to record or release.
+func (b *circuitBreaker) allow(now time.Time) (bool, uint64, func()) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	switch b.state {
+	case CircuitOpen:
+		if now.Sub(b.openedAt) < b.config.OpenTimeout {
+			return false, 0, nil
+		}
+		notify := b.transitionLocked(CircuitHalfOpen, now)
+		b.probes++
+		return true, b.generation, notify
+	case CircuitHalfOpen:
+		if b.probes >= b.config.HalfOpenMaxRequests {
+			return false, 0, nil
+		}
+		b.probes++
+	}
+	return true, b.generation, nil
+}
+
+// record accounts for the outcome of a request admitted under generation. Outcomes of requests admitted
+// before the latest transition are ignored.
+func (b *circuitBreaker) record(generation uint64, failed bool, now time.Time) func() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if generation != b.generation {
+		return nil
+	}
+	switch b.state {
+	case CircuitHalfOpen:
+		b.probes--
+		if failed {
+			return b.transitionLocked(CircuitOpen, now)
+		}
+		b.successes++
+		if b.successes >= b.config.HalfOpenMaxRequests {
+			return b.transitionLocked(CircuitClosed, now)
+		}
+	case CircuitClosed:
+		if now.Sub(b.windowStart) >= b.config.Window {
+			b.windowStart, b.requests, b.failures = now, 0, 0
+		}
+		b.requests++
+		if failed {
+			b.failures++
+		}
+		if b.requests >= b.config.MinRequests && float64(b.failures)/float64(b.requests) >= b.config.FailureRatio {
+			return b.transitionLocked(CircuitOpen, now)
+		}
+	}
+	return nil
+}
+
+// transitionLocked changes state and returns the callback notification to run once unlocked.
+func (b *circuitBreaker) transitionLocked(to CircuitState, now time.Time) func() {
+	from := b.state
+	b.state = to
+	b.generation++
+	b.probes, b.successes = 0, 0
+	switch to {
+	case CircuitOpen:
+		b.openedAt = now
+	case CircuitClosed:
+		b.windowStart, b.requests, b.failures = now, 0, 0
+	}
+	if b.config.OnStateChange == nil || from == to {
+		return nil
+	}
+	callback, family := b.config.OnStateChange, b.family
+	return func() { callback(family, from, to) }
+}
+
+func newCircuitBreakerInterceptor(config CircuitBreakerConfig) Interceptor {
+	config = config.withDefaults()
+	var mu sync.Mutex
+	breakers := make(map[EndpointFamily]*circuitBreaker)
+	breakerFor := func(family EndpointFamily) *circuitBreaker {
+		mu.Lock()
+		defer mu.Unlock()
+		b, ok := breakers[family]
+		if !ok {
+			b = &circuitBreaker{family: family, config: config, windowStart: time.Now()}
+			breakers[family] = b
+		}
+		return b
+	}
+
+	return func(ctx context.Context, info *CallInfo, req *http.Request, next Invoker) error {
+		family := EndpointFamilyOf(info.Path)
+		breaker := breakerFor(family)
+
+		allowed, generation, notify := breaker.allow(time.Now())
+		if notify != nil {
+			notify()
+		}
+		if !allowed {
+			return model.NewCircuitOpenError(fmt.Sprintf("circuit breaker for %s endpoints is open", family))
+		}
+
+		err := next(ctx, info, req)
+		if ctx.Err() != nil && err != nil {
+			// Caller cancellation says nothing about endpoint health.
+			breaker.release(generation)
+			return err
+		}
+		if notify := breaker.record(generation, model.IsRetryableError(err), time.Now()); notify != nil {
+			notify()
+		}
+		return err
+	}
+}
+
+// release gives back a half-open probe slot without recording an outcome.
+func (b *circuitBreaker) release(generation uint64) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if generation == b.generation && b.state == CircuitHalfOpen && b.probes > 0 {
+		b.probes--
+	}
+}
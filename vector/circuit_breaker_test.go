@@ -0,0 +1,174 @@
+// Copyright (c) 2025 Beijing Volcano Engine Technology Co., Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package vector
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/volcengine/vikingdb-go-sdk/vector/model"
+)
+
+func newTestBreaker(config CircuitBreakerConfig, now time.Time) *circuitBreaker {
+	return &circuitBreaker{family: FamilySearch, config: config.withDefaults(), windowStart: now}
+}
+
+func TestCircuitBreakerTransitions(t *testing.T) {
+	start := time.Unix(0, 0)
+	config := CircuitBreakerConfig{Window: time.Minute, MinRequests: 4, FailureRatio: 0.5, OpenTimeout: 10 * time.Second, HalfOpenMaxRequests: 2}
+
+	type step struct {
+		at        time.Duration
+		failed    bool
+		wantAllow bool
+		wantState CircuitState
+	}
+	cases := []struct {
+		name  string
+		steps []step
+	}{
+		{
+			name: "stays closed below min requests",
+			steps: []step{
+				{failed: true, wantAllow: true, wantState: CircuitClosed},
+				{failed: true, wantAllow: true, wantState: CircuitClosed},
+				{failed: true, wantAllow: true, wantState: CircuitClosed},
+			},
+		},
+		{
+			name: "trips at the failure ratio and rejects while open",
+			steps: []step{
+				{failed: false, wantAllow: true, wantState: CircuitClosed},
+				{failed: false, wantAllow: true, wantState: CircuitClosed},
+				{failed: true, wantAllow: true, wantState: CircuitClosed},
+				{failed: true, wantAllow: true, wantState: CircuitOpen},
+				{at: 9 * time.Second, wantAllow: false, wantState: CircuitOpen},
+			},
+		},
+		{
+			name: "window reset forgets old failures",
+			steps: []step{
+				{failed: true, wantAllow: true, wantState: CircuitClosed},
+				{failed: true, wantAllow: true, wantState: CircuitClosed},
+				{failed: true, wantAllow: true, wantState: CircuitClosed},
+				{at: 2 * time.Minute, failed: true, wantAllow: true, wantState: CircuitClosed},
+			},
+		},
+		{
+			name: "closes after enough successful probes",
+			steps: []step{
+				{failed: true, wantAllow: true}, {failed: true, wantAllow: true},
+				{failed: true, wantAllow: true}, {failed: true, wantAllow: true, wantState: CircuitOpen},
+				{at: 10 * time.Second, failed: false, wantAllow: true, wantState: CircuitHalfOpen},
+				{at: 10 * time.Second, failed: false, wantAllow: true, wantState: CircuitClosed},
+			},
+		},
+		{
+			name: "reopens on a failed probe",
+			steps: []step{
+				{failed: true, wantAllow: true}, {failed: true, wantAllow: true},
+				{failed: true, wantAllow: true}, {failed: true, wantAllow: true, wantState: CircuitOpen},
+				{at: 10 * time.Second, failed: true, wantAllow: true, wantState: CircuitOpen},
+				{at: 11 * time.Second, wantAllow: false, wantState: CircuitOpen},
+			},
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			b := newTestBreaker(config, start)
+			for i, s := range tc.steps {
+				now := start.Add(s.at)
+				allowed, generation, _ := b.allow(now)
+				if allowed != s.wantAllow {
+					t.Fatalf("step %d: allowed = %v, want %v", i, allowed, s.wantAllow)
+				}
+				if allowed {
+					b.record(generation, s.failed, now)
+				}
+				if s.wantState != CircuitClosed || i == len(tc.steps)-1 {
+					if b.state != s.wantState {
+						t.Fatalf("step %d: state = %s, want %s", i, b.state, s.wantState)
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestCircuitBreakerIgnoresStaleCompletions(t *testing.T) {
+	start := time.Unix(0, 0)
+	b := newTestBreaker(CircuitBreakerConfig{MinRequests: 2, FailureRatio: 0.5, OpenTimeout: time.Second, HalfOpenMaxRequests: 1}, start)
+
+	// A slow request admitted while closed outlives the trip and the move to half-open.
+	_, stale, _ := b.allow(start)
+	for i := 0; i < 2; i++ {
+		_, generation, _ := b.allow(start)
+		b.record(generation, true, start)
+	}
+	if b.state != CircuitOpen {
+		t.Fatalf("state = %s, want open", b.state)
+	}
+	later := start.Add(time.Second)
+	allowed, probe, _ := b.allow(later)
+	if !allowed || b.state != CircuitHalfOpen {
+		t.Fatalf("probe not admitted: allowed = %v, state = %s", allowed, b.state)
+	}
+
+	// Its success must neither free the probe slot nor close the breaker.
+	b.record(stale, false, later)
+	b.release(stale)
+	if b.state != CircuitHalfOpen || b.probes != 1 {
+		t.Fatalf("stale completion changed the breaker: state = %s, probes = %d", b.state, b.probes)
+	}
+	if allowed, _, _ := b.allow(later); allowed {
+		t.Fatal("a second probe was admitted")
+	}
+
+	b.record(probe, false, later)
+	if b.state != CircuitClosed {
+		t.Fatalf("state = %s, want closed after the real probe succeeded", b.state)
+	}
+}
+
+func TestCircuitBreakerInterceptor(t *testing.T) {
+	var transitions []CircuitState
+	intercept := newCircuitBreakerInterceptor(CircuitBreakerConfig{
+		MinRequests:   2,
+		OpenTimeout:   time.Hour,
+		OnStateChange: func(family EndpointFamily, from, to CircuitState) { transitions = append(transitions, to) },
+	})
+	failing := func(context.Context, *CallInfo, *http.Request) error {
+		return model.NewServiceUnavailableError("down")
+	}
+	info := &CallInfo{Path: "/api/vikingdb/data/search/vector"}
+	for i := 0; i < 2; i++ {
+		if err := intercept(context.Background(), info, nil, failing); err == nil {
+			t.Fatal("expected failure")
+		}
+	}
+	err := intercept(context.Background(), info, nil, func(context.Context, *CallInfo, *http.Request) error {
+		t.Fatal("request reached the endpoint while the breaker was open")
+		return nil
+	})
+	var sdkErr *model.Error
+	if !errors.As(err, &sdkErr) || sdkErr.Code != model.ErrCodeCircuitOpen {
+		t.Fatalf("err = %v, want circuit open", err)
+	}
+	// The request never left the client, so there is no status to retry or fail over on.
+	if sdkErr.StatusCode != 0 || model.IsRetryableError(err) {
+		t.Fatalf("circuit open error has status %d, retryable %v", sdkErr.StatusCode, model.IsRetryableError(err))
+	}
+	if len(transitions) != 1 || transitions[0] != CircuitOpen {
+		t.Fatalf("transitions = %v", transitions)
+	}
+
+	// Other families keep their own breaker.
+	write := &CallInfo{Path: "/api/vikingdb/data/upsert"}
+	if err := intercept(context.Background(), write, nil, func(context.Context, *CallInfo, *http.Request) error { return nil }); err != nil {
+		t.Fatalf("write family affected: %v", err)
+	}
+}
@@ -4,118 +4,72 @@
 package vector
 
 import (
-	"bytes"
 	"context"
 	"fmt"
 	"net/http"
-	"net/url"
 
+	"github.com/volcengine/vikingdb-go-sdk/internal/auth"
+	"github.com/volcengine/vikingdb-go-sdk/internal/core"
+	"github.com/volcengine/vikingdb-go-sdk/vector/credentials"
 	"github.com/volcengine/vikingdb-go-sdk/vector/model"
 	"github.com/volcengine/vikingdb-go-sdk/vector/utils"
 )
 
-const requestIDHeader = "X-Tt-Logid"
+const signingService = "vikingdb"
 
-type authKind int
-
-const (
-	authKindNone authKind = iota
-	authKindIAM
-	authKindAPIKey
-)
+// CredentialsProvider supplies credentials on every request; see the credentials package for the
+// environment, file, chain and STS implementations.
+type CredentialsProvider = credentials.Provider
 
 // Auth describes how the SDK should sign outgoing requests.
-type Auth struct {
-	kind      authKind
-	accessKey string
-	secretKey string
-	apiKey    string
-}
+type Auth = auth.Auth
 
 // AuthNone disables request signing.
 func AuthNone() Auth {
-	return Auth{kind: authKindNone}
+	return auth.None()
 }
 
 // AuthIAM configures AK/SK signing.
 func AuthIAM(accessKey, secretKey string) Auth {
-	return Auth{
-		kind:      authKindIAM,
-		accessKey: accessKey,
-		secretKey: secretKey,
-	}
-}
-
-// AuthAPIKey configures API key authentication.
-func AuthAPIKey(apiKey string) Auth {
-	return Auth{
-		kind:   authKindAPIKey,
-		apiKey: apiKey,
-	}
-}
-
-type authenticator interface {
-	apply(req *http.Request) (*http.Request, error)
+	return auth.IAM(accessKey, secretKey)
 }
 
-type noAuth struct{}
-
-func (noAuth) apply(req *http.Request) (*http.Request, error) {
-	return req, nil
-}
-
-type apiKeyAuth struct {
-	token string
-}
-
-func (a apiKeyAuth) apply(req *http.Request) (*http.Request, error) {
-	if a.token == "" {
-		return req, nil
-	}
-	req.Header.Set("Authorization", "Bearer "+a.token)
-	return req, nil
+// AuthIAMWithProvider configures AK/SK signing with credentials fetched from provider on every request,
+// so rotated keys and STS session tokens are picked up without rebuilding the client.
+func AuthIAMWithProvider(provider CredentialsProvider) Auth {
+	return auth.IAMWithProvider(provider)
 }
 
-type iamAuth struct {
-	ak     string
-	sk     string
-	region string
+// AuthAPIKey configures API key authentication.
+func AuthAPIKey(apiKey string) Auth {
+	return auth.APIKey(apiKey)
 }
 
-func (a iamAuth) apply(req *http.Request) (*http.Request, error) {
-	if a.ak == "" || a.sk == "" {
-		return nil, model.NewInvalidParameterError("access key and secret key cannot be empty")
-	}
-	return utils.SignRequestWithRegion(req, a.ak, a.sk, a.region), nil
+// AuthAPIKeyWithProvider configures API key authentication with the key fetched from provider on every request.
+func AuthAPIKeyWithProvider(provider CredentialsProvider) Auth {
+	return auth.APIKeyWithProvider(provider)
 }
 
 type transport struct {
-	config     Config
-	httpClient *http.Client
-	baseURL    *url.URL
-	auth       authenticator
-	userAgent  string
+	core      *core.Transport
+	endpoints *endpointPool
+	invoke    Invoker
+	callChain []CallInterceptor
+	latencies *latencyTracker
 }
 
 func newTransport(cfg Config, authConfig Auth) (*transport, error) {
-	if cfg.Endpoint == "" {
-		return nil, model.NewInvalidParameterError("endpoint cannot be empty")
-	}
-
 	defaults := DefaultConfig()
 
-	baseURL, err := url.Parse(cfg.Endpoint)
-	if err != nil {
-		return nil, model.NewErrorWithCause(model.ErrCodeInvalidParameter, "invalid endpoint", err, http.StatusBadRequest)
-	}
-	if baseURL.Scheme == "" {
-		baseURL.Scheme = "https"
-	}
-
 	if cfg.Region == "" {
 		cfg.Region = defaults.Region
 	}
 
+	endpoints, err := newEndpointPool(cfg)
+	if err != nil {
+		return nil, err
+	}
+
 	if cfg.Timeout <= 0 {
 		cfg.Timeout = defaults.Timeout
 	}
@@ -124,39 +78,69 @@ func newTransport(cfg Config, authConfig Auth) (*transport, error) {
 	if httpClient == nil {
 		httpClient = &http.Client{Timeout: cfg.Timeout}
 	}
+	// Health probes always reach the network; only API calls are recorded or replayed.
+	probeClient := httpClient
+	if cfg.Cassette != nil {
+		httpClient = cfg.Cassette.wrap(httpClient)
+	}
 
 	userAgent := cfg.UserAgent
 	if userAgent == "" {
 		userAgent = fmt.Sprintf("vikingdb-go-sdk/%s", Version)
 	}
 
-	var auth authenticator = noAuth{}
-	switch authConfig.kind {
-	case authKindIAM:
-		if authConfig.accessKey == "" || authConfig.secretKey == "" {
-			return nil, model.NewInvalidParameterError("access key and secret key cannot be empty")
-		}
-		auth = iamAuth{ak: authConfig.accessKey, sk: authConfig.secretKey, region: cfg.Region}
-	case authKindAPIKey:
-		if authConfig.apiKey == "" {
-			return nil, model.NewInvalidParameterError("api key cannot be empty")
-		}
-		auth = apiKeyAuth{token: authConfig.apiKey}
-	default:
+	if authConfig.Kind() == auth.KindNone {
 		return nil, model.NewInvalidParameterError("no auth")
 	}
+	authenticator, err := auth.NewAuthenticator(authConfig, signingService)
+	if err != nil {
+		return nil, err
+	}
 
 	if cfg.MaxRetries < 0 {
 		cfg.MaxRetries = 0
 	}
 
-	return &transport{
-		config:     cfg,
-		httpClient: httpClient,
-		baseURL:    baseURL,
-		auth:       auth,
-		userAgent:  userAgent,
-	}, nil
+	t := &transport{
+		core: core.NewTransport(core.Config{
+			HTTPClient:  httpClient,
+			Auth:        authenticator,
+			UserAgent:   userAgent,
+			MaxRetries:  cfg.MaxRetries,
+			RetryPolicy: cfg.RetryPolicy,
+		}),
+		endpoints: endpoints,
+		latencies: newLatencyTracker(),
+	}
+	interceptors := append([]Interceptor(nil), cfg.Interceptors...)
+	if cfg.CircuitBreaker != nil {
+		interceptors = append(interceptors, newCircuitBreakerInterceptor(*cfg.CircuitBreaker))
+	}
+	if len(cfg.RateLimits) > 0 {
+		interceptors = append(interceptors, newRateLimitInterceptor(cfg.RateLimits))
+	}
+	t.invoke = chainInterceptors(interceptors, t.send)
+	t.callChain = cfg.CallInterceptors
+	endpoints.startHealthChecks(probeClient, cfg.HealthCheckInterval, cfg.HealthCheckPath)
+	return t, nil
+}
+
+// API is the method set of Client, for callers that inject the SDK and substitute fakes in tests
+// (see the fakes package).
+type API interface {
+	Collection(base model.CollectionLocator) CollectionClient
+	CollectionAdmin() CollectionAdminClient
+	Index(base model.IndexLocator) IndexClient
+	IndexAdmin() IndexAdminClient
+	Embedding() EmbeddingClient
+	Rerank() RerankClient
+	Close() error
+}
+
+var _ API = (*Client)(nil)
+
+func errClientNotInitialized() error {
+	return model.NewInvalidParameterError("client is not initialized, use vector.New")
 }
 
 // Client represents the entry point for interacting with VikingDB services.
@@ -179,45 +163,64 @@ func New(auth Auth, opts ...ClientOption) (*Client, error) {
 	return &Client{transport: transport}, nil
 }
 
-// Collection scopes the client to collection operations using the supplied locator metadata.
-func (c *Client) Collection(base model.CollectionLocator) CollectionClient {
+// Close releases background resources such as endpoint health probes. The client must not be used afterwards.
+func (c *Client) Close() error {
 	if c == nil || c.transport == nil {
 		return nil
 	}
+	c.transport.endpoints.close()
+	return nil
+}
+
+// getTransport tolerates a nil or zero Client. The clients built on its result fail every call with
+// errClientNotInitialized instead of handing callers a nil interface.
+func (c *Client) getTransport() *transport {
+	if c == nil {
+		return nil
+	}
+	return c.transport
+}
+
+// Collection scopes the client to collection operations using the supplied locator metadata.
+func (c *Client) Collection(base model.CollectionLocator) CollectionClient {
 	return &collectionClient{
-		client:         c.transport,
+		client:         c.getTransport(),
 		collectionBase: base,
 	}
 }
 
+// CollectionAdmin exposes collection schema management (create, describe, list, update, drop).
+func (c *Client) CollectionAdmin() CollectionAdminClient {
+	return &collectionAdminClient{client: c.getTransport()}
+}
+
 // Index scopes the client to index operations using the supplied locator metadata.
 func (c *Client) Index(base model.IndexLocator) IndexClient {
-	if c == nil || c.transport == nil {
-		return nil
-	}
 	return &indexClient{
-		transport: c.transport,
+		transport: c.getTransport(),
 		indexBase: base,
 	}
 }
 
+// IndexAdmin exposes index control-plane operations (create, get, list, update, delete).
+func (c *Client) IndexAdmin() IndexAdminClient {
+	return &indexAdminClient{client: c.getTransport()}
+}
+
 // Embedding exposes embedding operations.
 func (c *Client) Embedding() EmbeddingClient {
-	if c == nil || c.transport == nil {
-		return nil
-	}
-	return &embeddingClient{client: c.transport}
+	return &embeddingClient{client: c.getTransport()}
 }
 
 // Rerank exposes rerank operations.
 func (c *Client) Rerank() RerankClient {
-	if c == nil || c.transport == nil {
-		return nil
-	}
-	return &rerankClient{client: c.transport}
+	return &rerankClient{client: c.getTransport()}
 }
 
 func (c *transport) doRequest(ctx context.Context, method, path string, request, response interface{}, opts ...RequestOption) error {
+	if c == nil {
+		return errClientNotInitialized()
+	}
 	if ctx == nil {
 		ctx = context.Background()
 	}
@@ -227,77 +230,62 @@ func (c *transport) doRequest(ctx context.Context, method, path string, request,
 		opt(requestOpts)
 	}
 
-	retries := requestOpts.MaxRetries
-	if retries <= 0 {
-		retries = c.config.MaxRetries
-	}
-	if retries < 0 {
-		retries = 0
-	}
+	coreOpts := requestOpts.toCore()
+	retries := c.core.Retries(coreOpts)
+	policy := c.core.RetryPolicy(coreOpts)
 
-	var body []byte
-	if request != nil {
-		serialized, err := utils.SerializeToJSON(request)
-		if err != nil {
-			return model.NewErrorWithCause(model.ErrCodeInvalidParameter, "failed to marshal request", err, http.StatusBadRequest)
-		}
-		body = serialized
-	}
-
-	return utils.Retry(retries, func() error {
-		req, err := c.buildRequest(ctx, method, path, body, requestOpts)
-		if err != nil {
-			return err
-		}
-
-		resp, err := utils.DoHTTPRequest(c.httpClient, req)
-		if err != nil {
+	body, err := core.MarshalBody(request)
+	if err != nil {
+		return err
+	}
+
+	info := &CallInfo{
+		Operation: operationName(path),
+		Method:    method,
+		Path:      path,
+		Attempt:   -1,
+		Request:   request,
+		Response:  response,
+	}
+	if locator, ok := request.(collectionScoped); ok {
+		info.Collection = locator.GetCollectionLocator()
+	}
+	if locator, ok := request.(indexScoped); ok {
+		info.IndexName = locator.GetIndexName()
+	}
+
+	return c.invokeCall(ctx, info, func(ctx context.Context, call *CallInfo) error {
+		attempt := 0
+		return utils.RetryWithPolicy(ctx, retries, policy, func() error {
+			idx, ep := c.endpoints.pick()
+			req, err := c.core.BuildRequest(ctx, ep.baseURL, method, path, body, coreOpts)
+			if err != nil {
+				return err
+			}
+
+			attemptInfo := *call
+			attemptInfo.Attempt = attempt
+			attemptInfo.Endpoint, attemptInfo.Region = ep.baseURL.String(), ep.region
+			// A reply from an earlier attempt must not be reported for one that got none.
+			attemptInfo.HTTPResponse, attemptInfo.RequestID = nil, ""
+			attempt++
+			err = c.invoke(ctx, &attemptInfo, req)
+			if reflectsEndpointHealth(ctx, err) {
+				c.endpoints.report(idx, err)
+			}
+			call.Endpoint, call.Region = attemptInfo.Endpoint, attemptInfo.Region
+			call.HTTPResponse, call.RequestID = attemptInfo.HTTPResponse, attemptInfo.RequestID
 			return err
-		}
-		defer resp.Body.Close()
-
-		return utils.ParseResponse(resp, response)
-	}, utils.IsRetryableError)
+		})
+	})
 }
 
-func (c *transport) buildRequest(ctx context.Context, method, path string, body []byte, opts *RequestOptions) (*http.Request, error) {
-	targetURL := c.baseURL.ResolveReference(&url.URL{Path: path})
-	if len(opts.Query) > 0 {
-		query := targetURL.Query()
-		for k, v := range opts.Query {
-			query.Set(k, v)
-		}
-		targetURL.RawQuery = query.Encode()
-	}
-
-	var buf *bytes.Reader
-	if len(body) > 0 {
-		buf = bytes.NewReader(body)
-	}
-
-	req, err := http.NewRequestWithContext(ctx, method, targetURL.String(), buf)
-	if err != nil {
-		return nil, model.NewErrorWithCause(model.ErrCodeUnknown, "failed to create request", err, http.StatusBadRequest)
-	}
-
-	if len(body) > 0 {
-		req.Header.Set("Content-Type", "application/json")
-	}
-	req.Header.Set("Accept", "application/json")
-	if c.userAgent != "" {
-		req.Header.Set("User-Agent", c.userAgent)
-	}
-
-	for k, v := range opts.Headers {
-		req.Header.Set(k, v)
-	}
-	if opts.RequestID != "" {
-		req.Header.Set(requestIDHeader, opts.RequestID)
-	}
-
-	signedReq, err := c.auth.apply(req)
-	if err != nil {
-		return nil, err
+// send is the innermost Invoker: it signs the request, executes it and decodes the reply.
+func (c *transport) send(ctx context.Context, info *CallInfo, req *http.Request) error {
+	resp, err := c.core.Send(req, info.Region, info.Response)
+	if resp != nil {
+		info.HTTPResponse = resp
+		info.RequestID = resp.Header.Get(core.RequestIDHeader)
 	}
-	return signedReq, nil
+	return err
 }
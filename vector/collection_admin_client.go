@@ -0,0 +1,148 @@
+// Copyright (c) 2025 Beijing Volcano Engine Technology Co., Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package vector
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/volcengine/vikingdb-go-sdk/vector/model"
+)
+
+type collectionAdminClient struct {
+	client *transport
+}
+
+func (c *collectionAdminClient) CreateCollection(ctx context.Context, request model.CreateCollectionRequest, opts ...RequestOption) (*model.CreateCollectionResponse, error) {
+	response := &model.CreateCollectionResponse{}
+	if err := validateCollectionSchema(request.CollectionName, request.Fields, request.Vectorize); err != nil {
+		return response, err
+	}
+	err := c.client.doRequest(ctx, http.MethodPost, "/api/vikingdb/collection/create", request, response, opts...)
+	return response, classifyCollectionError(err, model.ErrCodeCollectionCreateFailed)
+}
+
+func (c *collectionAdminClient) DescribeCollection(ctx context.Context, request model.DescribeCollectionRequest, opts ...RequestOption) (*model.DescribeCollectionResponse, error) {
+	response := &model.DescribeCollectionResponse{}
+	err := c.client.doRequest(ctx, http.MethodPost, "/api/vikingdb/collection/get", request, response, opts...)
+	return response, classifyCollectionError(err, "")
+}
+
+func (c *collectionAdminClient) ListCollections(ctx context.Context, request model.ListCollectionsRequest, opts ...RequestOption) (*model.ListCollectionsResponse, error) {
+	response := &model.ListCollectionsResponse{}
+	err := c.client.doRequest(ctx, http.MethodPost, "/api/vikingdb/collection/list", request, response, opts...)
+	return response, err
+}
+
+func (c *collectionAdminClient) UpdateCollection(ctx context.Context, request model.UpdateCollectionRequest, opts ...RequestOption) (*model.UpdateCollectionResponse, error) {
+	response := &model.UpdateCollectionResponse{}
+	for _, field := range request.Fields {
+		if field.IsPrimaryKey {
+			return response, model.NewInvalidParameterError(fmt.Sprintf("field %q: primary key cannot be added to an existing collection", field.FieldName))
+		}
+		if err := validateFieldSchema(field); err != nil {
+			return response, err
+		}
+	}
+	err := c.client.doRequest(ctx, http.MethodPost, "/api/vikingdb/collection/update", request, response, opts...)
+	return response, classifyCollectionError(err, model.ErrCodeCollectionUpdateFailed)
+}
+
+func (c *collectionAdminClient) DropCollection(ctx context.Context, request model.DropCollectionRequest, opts ...RequestOption) (*model.DropCollectionResponse, error) {
+	response := &model.DropCollectionResponse{}
+	err := c.client.doRequest(ctx, http.MethodPost, "/api/vikingdb/collection/delete", request, response, opts...)
+	return response, classifyCollectionError(err, model.ErrCodeCollectionDeleteFailed)
+}
+
+// classifyCollectionError maps service failures without a specific code onto the collection error codes.
+// Only errors the server answered with an HTTP error status are relabelled; transport and decoding failures
+// keep their own code.
+func classifyCollectionError(err error, fallback model.ErrorCode) error {
+	sdkErr, ok := err.(*model.Error)
+	if !ok || sdkErr.StatusCode < http.StatusBadRequest || (sdkErr.Code != "" && sdkErr.Code != model.ErrCodeUnknown) {
+		return err
+	}
+	switch {
+	case sdkErr.StatusCode == http.StatusConflict:
+		sdkErr.Code = model.ErrCodeCollectionAlreadyExists
+	case sdkErr.StatusCode == http.StatusNotFound:
+		sdkErr.Code = model.ErrCodeCollectionNotExists
+	case fallback != "":
+		sdkErr.Code = fallback
+	}
+	return sdkErr
+}
+
+func validateCollectionSchema(name string, fields []model.FieldSchema, vectorize *model.VectorizeConfig) error {
+	if name == "" {
+		return model.NewInvalidParameterError("collection name cannot be empty")
+	}
+	if len(fields) == 0 {
+		return model.NewInvalidParameterError("collection schema must declare at least one field")
+	}
+
+	types := make(map[string]model.FieldType, len(fields))
+	primaryKeys := 0
+	for _, field := range fields {
+		if err := validateFieldSchema(field); err != nil {
+			return err
+		}
+		if _, exists := types[field.FieldName]; exists {
+			return model.NewInvalidParameterError(fmt.Sprintf("field %q is declared more than once", field.FieldName))
+		}
+		types[field.FieldName] = field.FieldType
+		if field.IsPrimaryKey {
+			primaryKeys++
+		}
+	}
+	if primaryKeys > 1 {
+		return model.NewInvalidParameterError("collection schema can declare at most one primary key")
+	}
+
+	if vectorize == nil {
+		return nil
+	}
+	for _, conf := range []*model.VectorizeModelConf{vectorize.Dense, vectorize.Sparse} {
+		if conf == nil {
+			continue
+		}
+		if conf.ModelName == "" {
+			return model.NewInvalidParameterError("vectorize model name cannot be empty")
+		}
+		if conf.TextField == "" && conf.ImageField == "" && conf.VideoField == "" {
+			return model.NewInvalidParameterError(fmt.Sprintf("vectorize model %q needs a text, image or video field", conf.ModelName))
+		}
+		for _, source := range []string{conf.TextField, conf.ImageField, conf.VideoField} {
+			if source == "" {
+				continue
+			}
+			if _, ok := types[source]; !ok {
+				return model.NewInvalidParameterError(fmt.Sprintf("vectorize source field %q is not declared in the schema", source))
+			}
+		}
+	}
+	return nil
+}
+
+func validateFieldSchema(field model.FieldSchema) error {
+	if field.FieldName == "" {
+		return model.NewInvalidParameterError("field name cannot be empty")
+	}
+	switch field.FieldType {
+	case model.FieldTypeVector:
+		if field.Dim <= 0 {
+			return model.NewInvalidParameterError(fmt.Sprintf("vector field %q requires a positive dim", field.FieldName))
+		}
+	case model.FieldTypeInt64, model.FieldTypeFloat32, model.FieldTypeString, model.FieldTypeBool,
+		model.FieldTypeListString, model.FieldTypeListInt64, model.FieldTypeSparseVector,
+		model.FieldTypeText, model.FieldTypeDateTime, model.FieldTypeGeoPoint:
+	default:
+		return model.NewInvalidParameterError(fmt.Sprintf("field %q has unsupported type %q", field.FieldName, field.FieldType))
+	}
+	if field.IsPrimaryKey && field.FieldType != model.FieldTypeInt64 && field.FieldType != model.FieldTypeString {
+		return model.NewInvalidParameterError(fmt.Sprintf("primary key %q must be int64 or string", field.FieldName))
+	}
+	return nil
+}
@@ -0,0 +1,99 @@
+// Copyright (c) 2025 Beijing Volcano Engine Technology Co., Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package vector
+
+import (
+	"context"
+	"errors"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	"github.com/volcengine/vikingdb-go-sdk/vector/model"
+)
+
+func TestCollectionAdminErrors(t *testing.T) {
+	validSchema := model.CreateCollectionRequest{
+		CollectionName: "docs",
+		Fields: []model.FieldSchema{
+			{FieldName: "id", FieldType: model.FieldTypeString, IsPrimaryKey: true},
+			{FieldName: "vector", FieldType: model.FieldTypeVector, Dim: 4},
+		},
+	}
+	cases := []struct {
+		name      string
+		request   model.CreateCollectionRequest
+		status    int
+		reply     string
+		down      bool
+		wantCode  model.ErrorCode
+		wantCalls int32
+	}{
+		{name: "validation failure", request: model.CreateCollectionRequest{CollectionName: "docs"}, wantCode: model.ErrCodeInvalidParameter},
+		{name: "conflict", request: validSchema, status: http.StatusConflict, reply: `{"message":"exists"}`, wantCode: model.ErrCodeCollectionAlreadyExists, wantCalls: 1},
+		{name: "server failure without a code", request: validSchema, status: http.StatusInternalServerError, reply: "oops", wantCode: model.ErrCodeCollectionCreateFailed, wantCalls: 1},
+		{name: "specific server code is kept", request: validSchema, status: http.StatusBadRequest, reply: `{"code":"InvalidParameter","message":"bad"}`, wantCode: model.ErrCodeInvalidParameter, wantCalls: 1},
+		{name: "undecodable success keeps its code", request: validSchema, status: http.StatusOK, reply: "{", wantCode: model.ErrCodeUnknown, wantCalls: 1},
+		{name: "transport failure keeps its code", request: validSchema, down: true, wantCode: model.ErrCodeHTTPRequestFailed},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			var calls int32
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				atomic.AddInt32(&calls, 1)
+				_, _ = io.Copy(ioutil.Discard, r.Body)
+				w.WriteHeader(tc.status)
+				_, _ = w.Write([]byte(tc.reply))
+			}))
+			defer srv.Close()
+			client, err := New(AuthAPIKey("key"), WithEndpoint(srv.URL), WithMaxRetries(0))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if tc.down {
+				srv.Close()
+			}
+
+			resp, err := client.CollectionAdmin().CreateCollection(context.Background(), tc.request)
+			if resp == nil {
+				t.Fatal("CreateCollection returned a nil response")
+			}
+			var sdkErr *model.Error
+			if !errors.As(err, &sdkErr) || sdkErr.Code != tc.wantCode {
+				t.Fatalf("err = %v, want code %s", err, tc.wantCode)
+			}
+			if got := atomic.LoadInt32(&calls); got != tc.wantCalls {
+				t.Fatalf("server saw %d requests, want %d", got, tc.wantCalls)
+			}
+		})
+	}
+}
+
+func TestClassifyCollectionError(t *testing.T) {
+	cases := []struct {
+		name     string
+		err      *model.Error
+		fallback model.ErrorCode
+		want     model.ErrorCode
+	}{
+		{name: "not found", err: model.NewErrorWithStatusCode(model.ErrCodeUnknown, "", http.StatusNotFound), want: model.ErrCodeCollectionNotExists},
+		{name: "fallback for empty code", err: model.NewErrorWithStatusCode("", "", http.StatusBadGateway), fallback: model.ErrCodeCollectionDeleteFailed, want: model.ErrCodeCollectionDeleteFailed},
+		{name: "no fallback", err: model.NewErrorWithStatusCode("", "", http.StatusBadGateway), want: ""},
+		{name: "no server status", err: model.NewErrorWithStatusCode(model.ErrCodeUnknown, "", 0), fallback: model.ErrCodeCollectionUpdateFailed, want: model.ErrCodeUnknown},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := classifyCollectionError(tc.err, tc.fallback)
+			if got := err.(*model.Error).Code; got != tc.want {
+				t.Fatalf("code = %q, want %q", got, tc.want)
+			}
+		})
+	}
+	if err := classifyCollectionError(nil, model.ErrCodeCollectionCreateFailed); err != nil {
+		t.Fatalf("nil error classified as %v", err)
+	}
+}
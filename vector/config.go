@@ -5,6 +5,8 @@ package vector
 import (
 	"net/http"
 	"time"
+
+	"github.com/volcengine/vikingdb-go-sdk/vector/utils"
 )
 
 // Version denotes the SDK version reported via the User-Agent header.
@@ -18,6 +20,27 @@ type Config struct {
 	MaxRetries int
 	HTTPClient *http.Client
 	UserAgent  string
+
+	// Endpoints, when set, replaces Endpoint and Region with an ordered list of endpoints.
+	Endpoints           []EndpointConfig
+	FailoverPolicy      FailoverPolicy
+	HealthCheckInterval time.Duration
+	HealthCheckPath     string
+
+	// RetryPolicy controls backoff and retry classification; utils.DefaultRetryPolicy applies when nil.
+	RetryPolicy *utils.RetryPolicy
+
+	// RateLimits holds client-side budgets per endpoint family, see WithRateLimit.
+	RateLimits map[EndpointFamily]RateLimit
+
+	// CircuitBreaker enables per-family circuit breaking when set, see WithCircuitBreaker.
+	CircuitBreaker *CircuitBreakerConfig
+
+	Interceptors     []Interceptor
+	CallInterceptors []CallInterceptor
+
+	// Cassette records or replays every HTTP exchange when set, see WithCassette.
+	Cassette *Cassette
 }
 
 // DefaultConfig returns the baseline configuration.
@@ -57,6 +80,13 @@ func WithMaxRetries(maxRetries int) ClientOption {
 	}
 }
 
+// WithRetryPolicy sets the client-wide backoff and retry classification policy.
+func WithRetryPolicy(policy utils.RetryPolicy) ClientOption {
+	return func(c *Config) {
+		c.RetryPolicy = &policy
+	}
+}
+
 func WithHTTPClient(httpClient *http.Client) ClientOption {
 	return func(c *Config) {
 		c.HTTPClient = httpClient
@@ -68,3 +98,17 @@ func WithUserAgent(userAgent string) ClientOption {
 		c.UserAgent = userAgent
 	}
 }
+
+// WithInterceptors appends interceptors that wrap every HTTP attempt, in the order given.
+func WithInterceptors(interceptors ...Interceptor) ClientOption {
+	return func(c *Config) {
+		c.Interceptors = append(c.Interceptors, interceptors...)
+	}
+}
+
+// WithCallInterceptors appends interceptors that wrap every logical call around its retry attempts.
+func WithCallInterceptors(interceptors ...CallInterceptor) ClientOption {
+	return func(c *Config) {
+		c.CallInterceptors = append(c.CallInterceptors, interceptors...)
+	}
+}
@@ -0,0 +1,238 @@
+// Copyright (c) 2025 Beijing Volcano Engine Technology Co., Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package credentials supplies the secrets used to authenticate SDK requests. Providers are consulted on
+// every request, so rotated keys are picked up without rebuilding clients.
+package credentials
+
+import (
+	"context"
+	"errors"
+	"os"
+	"strings"
+	"sync"
+	"time"
+)
+
+// ErrNoCredentials is returned by providers that have nothing to offer, letting a Chain move on.
+var ErrNoCredentials = errors.New("credentials: no credentials available")
+
+// Value holds a set of credentials. IAM signing uses AccessKey, SecretKey and the optional SessionToken;
+// API key authentication uses APIKey.
+type Value struct {
+	AccessKey    string
+	SecretKey    string
+	SessionToken string
+	APIKey       string
+	// Expires is the moment the credentials stop being valid; zero means they never expire.
+	Expires time.Time
+}
+
+// HasKeys reports whether v carries an AK/SK pair.
+func (v Value) HasKeys() bool {
+	return v.AccessKey != "" && v.SecretKey != ""
+}
+
+// IsEmpty reports whether v carries neither an AK/SK pair nor an API key.
+func (v Value) IsEmpty() bool {
+	return !v.HasKeys() && v.APIKey == ""
+}
+
+// Expired reports whether v is expired at now.
+func (v Value) Expired(now time.Time) bool {
+	return !v.Expires.IsZero() && !now.Before(v.Expires)
+}
+
+// Provider retrieves credentials. Implementations must be safe for concurrent use.
+type Provider interface {
+	Retrieve(ctx context.Context) (Value, error)
+}
+
+// ProviderFunc adapts a function to the Provider interface.
+type ProviderFunc func(ctx context.Context) (Value, error)
+
+// Retrieve calls f.
+func (f ProviderFunc) Retrieve(ctx context.Context) (Value, error) {
+	return f(ctx)
+}
+
+// StaticProvider always returns the same credentials.
+type StaticProvider struct {
+	Value Value
+}
+
+// NewStaticProvider returns a provider for a fixed AK/SK pair.
+func NewStaticProvider(accessKey, secretKey string) *StaticProvider {
+	return &StaticProvider{Value: Value{AccessKey: accessKey, SecretKey: secretKey}}
+}
+
+// NewStaticAPIKeyProvider returns a provider for a fixed API key.
+func NewStaticAPIKeyProvider(apiKey string) *StaticProvider {
+	return &StaticProvider{Value: Value{APIKey: apiKey}}
+}
+
+// Retrieve returns the static credentials.
+func (p *StaticProvider) Retrieve(context.Context) (Value, error) {
+	if p.Value.IsEmpty() {
+		return Value{}, ErrNoCredentials
+	}
+	return p.Value, nil
+}
+
+// Environment variables read by EnvProvider by default.
+const (
+	EnvAccessKey    = "VIKINGDB_AK"
+	EnvSecretKey    = "VIKINGDB_SK"
+	EnvSessionToken = "VIKINGDB_SESSION_TOKEN"
+	EnvAPIKey       = "VIKINGDB_API_KEY"
+)
+
+// EnvProvider reads credentials from environment variables on every call. Empty variable names fall back
+// to the Env* defaults.
+type EnvProvider struct {
+	AccessKeyVar    string
+	SecretKeyVar    string
+	SessionTokenVar string
+	APIKeyVar       string
+}
+
+// NewEnvProvider returns an EnvProvider using the default variable names.
+func NewEnvProvider() *EnvProvider {
+	return &EnvProvider{}
+}
+
+// Retrieve reads the environment.
+func (p *EnvProvider) Retrieve(context.Context) (Value, error) {
+	v := Value{
+		AccessKey:    os.Getenv(orDefault(p.AccessKeyVar, EnvAccessKey)),
+		SecretKey:    os.Getenv(orDefault(p.SecretKeyVar, EnvSecretKey)),
+		SessionToken: os.Getenv(orDefault(p.SessionTokenVar, EnvSessionToken)),
+		APIKey:       os.Getenv(orDefault(p.APIKeyVar, EnvAPIKey)),
+	}
+	if v.IsEmpty() {
+		return Value{}, ErrNoCredentials
+	}
+	return v, nil
+}
+
+func orDefault(value, fallback string) string {
+	if strings.TrimSpace(value) == "" {
+		return fallback
+	}
+	return value
+}
+
+// ChainProvider returns the credentials of the first provider that has some.
+type ChainProvider struct {
+	Providers []Provider
+}
+
+// NewChainProvider returns a provider trying each of providers in order.
+func NewChainProvider(providers ...Provider) *ChainProvider {
+	return &ChainProvider{Providers: providers}
+}
+
+// Retrieve walks the chain. Providers reporting ErrNoCredentials are skipped; any other error stops the walk.
+func (p *ChainProvider) Retrieve(ctx context.Context) (Value, error) {
+	for _, provider := range p.Providers {
+		v, err := provider.Retrieve(ctx)
+		if err == nil && !v.IsEmpty() {
+			return v, nil
+		}
+		if err != nil && !errors.Is(err, ErrNoCredentials) {
+			return Value{}, err
+		}
+	}
+	return Value{}, ErrNoCredentials
+}
+
+// CachingProvider caches the credentials of an expiring source, such as STS, and refreshes them shortly
+// before they expire. Concurrent callers share a single refresh, and the lock guarding the cache is never
+// held while the source is consulted.
+type CachingProvider struct {
+	source        Provider
+	refreshWindow time.Duration
+	now           func() time.Time
+
+	mu     sync.Mutex
+	cached Value
+	valid  bool
+	flight *refreshFlight
+}
+
+// refreshFlight is a refresh in progress; done is closed once value and err are set.
+type refreshFlight struct {
+	done  chan struct{}
+	value Value
+	err   error
+}
+
+// NewCachingProvider wraps source, refreshing refreshWindow before expiry. Credentials without an
+// expiry are cached forever.
+func NewCachingProvider(source Provider, refreshWindow time.Duration) *CachingProvider {
+	return &CachingProvider{source: source, refreshWindow: refreshWindow, now: time.Now}
+}
+
+// Retrieve returns the cached credentials, refreshing them when they are about to expire. While another
+// caller refreshes, credentials that are still valid are served without waiting.
+func (p *CachingProvider) Retrieve(ctx context.Context) (Value, error) {
+	for {
+		p.mu.Lock()
+		now := p.now()
+		if p.valid && !p.cached.Expired(now.Add(p.refreshWindow)) {
+			v := p.cached
+			p.mu.Unlock()
+			return v, nil
+		}
+		if flight := p.flight; flight != nil {
+			if p.valid && !p.cached.Expired(now) {
+				v := p.cached
+				p.mu.Unlock()
+				return v, nil
+			}
+			p.mu.Unlock()
+			select {
+			case <-flight.done:
+			case <-ctx.Done():
+				return Value{}, ctx.Err()
+			}
+			// A refresh abandoned by its own caller's context says nothing about ours; try again.
+			if isContextError(flight.err) && ctx.Err() == nil {
+				continue
+			}
+			return flight.value, flight.err
+		}
+		flight := &refreshFlight{done: make(chan struct{})}
+		p.flight = flight
+		p.mu.Unlock()
+
+		v, err := p.source.Retrieve(ctx)
+
+		p.mu.Lock()
+		if err == nil {
+			p.cached, p.valid = v, true
+		} else if p.valid && !p.cached.Expired(p.now()) {
+			// Keep serving credentials that are still valid if the refresh fails.
+			v, err = p.cached, nil
+		}
+		flight.value, flight.err = v, err
+		p.flight = nil
+		p.mu.Unlock()
+		close(flight.done)
+		if err != nil {
+			return Value{}, err
+		}
+		return v, nil
+	}
+}
+
+func isContextError(err error) bool {
+	return errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded)
+}
+
+// Invalidate drops the cached credentials so the next Retrieve refreshes them.
+func (p *CachingProvider) Invalidate() {
+	p.mu.Lock()
+	p.valid = false
+	p.mu.Unlock()
+}
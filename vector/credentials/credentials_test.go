@@ -0,0 +1,228 @@
+// Copyright (c) 2025 Beijing Volcano Engine Technology Co., Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package credentials
+
+import (
+	"context"
+	"errors"
+	"net"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestCachingProvider(t *testing.T) {
+	start := time.Unix(0, 0)
+	errSource := errors.New("sts unavailable")
+	expiring := func(after time.Duration, key string) Value {
+		return Value{AccessKey: key, SecretKey: "sk", Expires: start.Add(after)}
+	}
+
+	type step struct {
+		at      time.Duration
+		source  Value
+		err     error
+		wantKey string
+		wantErr error
+	}
+	cases := []struct {
+		name          string
+		steps         []step
+		wantRetrieves int
+	}{
+		{
+			name: "serves the cache until the refresh window",
+			steps: []step{
+				{source: expiring(10*time.Minute, "a"), wantKey: "a"},
+				{at: 4 * time.Minute, source: expiring(20*time.Minute, "b"), wantKey: "a"},
+				{at: 6 * time.Minute, source: expiring(20*time.Minute, "b"), wantKey: "b"},
+			},
+			wantRetrieves: 2,
+		},
+		{
+			name: "keeps valid credentials when a refresh fails",
+			steps: []step{
+				{source: expiring(10*time.Minute, "a"), wantKey: "a"},
+				{at: 6 * time.Minute, err: errSource, wantKey: "a"},
+			},
+			wantRetrieves: 2,
+		},
+		{
+			name: "fails once the cached credentials expired",
+			steps: []step{
+				{source: expiring(10*time.Minute, "a"), wantKey: "a"},
+				{at: 10 * time.Minute, err: errSource, wantErr: errSource},
+			},
+			wantRetrieves: 2,
+		},
+		{
+			name: "credentials without expiry are cached forever",
+			steps: []step{
+				{source: Value{AccessKey: "a", SecretKey: "sk"}, wantKey: "a"},
+				{at: 1000 * time.Hour, source: Value{AccessKey: "b", SecretKey: "sk"}, wantKey: "a"},
+			},
+			wantRetrieves: 1,
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			var now time.Time
+			var current step
+			retrieves := 0
+			p := NewCachingProvider(ProviderFunc(func(context.Context) (Value, error) {
+				retrieves++
+				return current.source, current.err
+			}), 5*time.Minute)
+			p.now = func() time.Time { return now }
+
+			for i, s := range tc.steps {
+				now, current = start.Add(s.at), s
+				v, err := p.Retrieve(context.Background())
+				if !errors.Is(err, s.wantErr) {
+					t.Fatalf("step %d: err = %v, want %v", i, err, s.wantErr)
+				}
+				if v.AccessKey != s.wantKey {
+					t.Fatalf("step %d: key = %q, want %q", i, v.AccessKey, s.wantKey)
+				}
+			}
+			if retrieves != tc.wantRetrieves {
+				t.Fatalf("source consulted %d times, want %d", retrieves, tc.wantRetrieves)
+			}
+		})
+	}
+}
+
+func TestCachingProviderSharesOneRefresh(t *testing.T) {
+	var calls int32
+	release := make(chan struct{})
+	p := NewCachingProvider(ProviderFunc(func(context.Context) (Value, error) {
+		atomic.AddInt32(&calls, 1)
+		<-release
+		return Value{AccessKey: "a", SecretKey: "sk", Expires: time.Now().Add(time.Hour)}, nil
+	}), time.Minute)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if v, err := p.Retrieve(context.Background()); err != nil || v.AccessKey != "a" {
+				t.Errorf("Retrieve = %+v, %v", v, err)
+			}
+		}()
+	}
+	time.Sleep(20 * time.Millisecond)
+	close(release)
+	wg.Wait()
+	if calls != 1 {
+		t.Fatalf("source consulted %d times, want one shared refresh", calls)
+	}
+}
+
+func TestCachingProviderServesValidCacheDuringRefresh(t *testing.T) {
+	started, release := make(chan struct{}), make(chan struct{})
+	var calls int32
+	p := NewCachingProvider(ProviderFunc(func(context.Context) (Value, error) {
+		if atomic.AddInt32(&calls, 1) == 1 {
+			// Valid, but already inside the refresh window.
+			return Value{AccessKey: "a", SecretKey: "sk", Expires: time.Now().Add(time.Minute)}, nil
+		}
+		close(started)
+		<-release
+		return Value{AccessKey: "b", SecretKey: "sk", Expires: time.Now().Add(time.Hour)}, nil
+	}), 5*time.Minute)
+	if _, err := p.Retrieve(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+
+	refreshed := make(chan Value, 1)
+	go func() {
+		v, _ := p.Retrieve(context.Background())
+		refreshed <- v
+	}()
+	<-started
+
+	done := make(chan Value, 1)
+	go func() {
+		v, _ := p.Retrieve(context.Background())
+		done <- v
+	}()
+	select {
+	case v := <-done:
+		if v.AccessKey != "a" {
+			t.Fatalf("key = %q, want the cached credentials", v.AccessKey)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("Retrieve blocked behind the refresh")
+	}
+	close(release)
+	if v := <-refreshed; v.AccessKey != "b" {
+		t.Fatalf("refreshed key = %q", v.AccessKey)
+	}
+}
+
+func TestCachingProviderWaiterRetriesAfterCancelledRefresh(t *testing.T) {
+	var calls int32
+	leaderStarted := make(chan struct{})
+	p := NewCachingProvider(ProviderFunc(func(ctx context.Context) (Value, error) {
+		if atomic.AddInt32(&calls, 1) == 1 {
+			close(leaderStarted)
+			<-ctx.Done()
+			return Value{}, ctx.Err()
+		}
+		return Value{AccessKey: "a", SecretKey: "sk"}, nil
+	}), time.Minute)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	leader := make(chan error, 1)
+	go func() {
+		_, err := p.Retrieve(ctx)
+		leader <- err
+	}()
+	<-leaderStarted
+
+	waiter := make(chan Value, 1)
+	go func() {
+		v, _ := p.Retrieve(context.Background())
+		waiter <- v
+	}()
+	time.Sleep(10 * time.Millisecond)
+	cancel()
+	if err := <-leader; !errors.Is(err, context.Canceled) {
+		t.Fatalf("leader err = %v", err)
+	}
+	if v := <-waiter; v.AccessKey != "a" {
+		t.Fatalf("waiter got %+v, want a fresh refresh of its own", v)
+	}
+}
+
+func TestAssumeRoleHonoursContext(t *testing.T) {
+	// A listener that accepts connections but never answers stands in for a hung STS endpoint.
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer ln.Close()
+	go func() {
+		for {
+			conn, err := ln.Accept()
+			if err != nil {
+				return
+			}
+			defer conn.Close()
+		}
+	}()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	start := time.Now()
+	_, err = assumeRole(ctx, NewStaticProvider("ak", "sk"), AssumeRoleConfig{RoleTrn: "trn:iam::1:role/r", RoleSessionName: "s", Host: ln.Addr().String()})
+	if err == nil {
+		t.Fatal("expected an error")
+	}
+	if elapsed := time.Since(start); elapsed > 2*time.Second {
+		t.Fatalf("assumeRole ignored the context: returned after %v", elapsed)
+	}
+}
@@ -0,0 +1,94 @@
+// Copyright (c) 2025 Beijing Volcano Engine Technology Co., Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package credentials
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"sync"
+	"time"
+)
+
+const defaultFileCheckInterval = time.Second
+
+// fileContent is the JSON document read by FileProvider.
+type fileContent struct {
+	AccessKey    string    `json:"access_key"`
+	SecretKey    string    `json:"secret_key"`
+	SessionToken string    `json:"session_token,omitempty"`
+	APIKey       string    `json:"api_key,omitempty"`
+	Expires      time.Time `json:"expires,omitempty"`
+}
+
+// FileProvider reads credentials from a JSON file and reloads it whenever its modification time or size
+// changes, so rotated secrets written by an agent are picked up on the next request:
+//
+//	{"access_key": "...", "secret_key": "...", "session_token": "...", "expires": "2025-01-01T00:00:00Z"}
+type FileProvider struct {
+	path          string
+	checkInterval time.Duration
+
+	mu        sync.Mutex
+	value     Value
+	modTime   time.Time
+	size      int64
+	lastCheck time.Time
+	loaded    bool
+}
+
+// NewFileProvider watches the file at path, checking for changes at most once per checkInterval
+// (one second when zero).
+func NewFileProvider(path string, checkInterval time.Duration) *FileProvider {
+	if checkInterval <= 0 {
+		checkInterval = defaultFileCheckInterval
+	}
+	return &FileProvider{path: path, checkInterval: checkInterval}
+}
+
+// Retrieve returns the file's credentials, reloading them when the file changed.
+func (p *FileProvider) Retrieve(context.Context) (Value, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	now := time.Now()
+	if p.loaded && now.Sub(p.lastCheck) < p.checkInterval {
+		return p.value, nil
+	}
+	p.lastCheck = now
+
+	info, err := os.Stat(p.path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return Value{}, ErrNoCredentials
+		}
+		return Value{}, fmt.Errorf("credentials: stat %s: %w", p.path, err)
+	}
+	if p.loaded && info.ModTime().Equal(p.modTime) && info.Size() == p.size {
+		return p.value, nil
+	}
+
+	raw, err := ioutil.ReadFile(p.path)
+	if err != nil {
+		return Value{}, fmt.Errorf("credentials: read %s: %w", p.path, err)
+	}
+	var content fileContent
+	if err := json.Unmarshal(raw, &content); err != nil {
+		return Value{}, fmt.Errorf("credentials: parse %s: %w", p.path, err)
+	}
+	v := Value{
+		AccessKey:    content.AccessKey,
+		SecretKey:    content.SecretKey,
+		SessionToken: content.SessionToken,
+		APIKey:       content.APIKey,
+		Expires:      content.Expires,
+	}
+	if v.IsEmpty() {
+		return Value{}, ErrNoCredentials
+	}
+	p.value, p.modTime, p.size, p.loaded = v, info.ModTime(), info.Size(), true
+	return v, nil
+}
@@ -0,0 +1,192 @@
+// Copyright (c) 2025 Beijing Volcano Engine Technology Co., Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package vector
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync"
+	"unicode/utf8"
+
+	"github.com/volcengine/vikingdb-go-sdk/vector/model"
+)
+
+const (
+	defaultEmbedMaxItems    = 64
+	defaultEmbedMaxTokens   = 8192
+	defaultEmbedConcurrency = 4
+
+	// Rough per-item costs of non-text inputs for the default estimator.
+	embedImageTokens = 1024
+	embedVideoTokens = defaultEmbedMaxTokens
+)
+
+// EmbedderConfig controls how an Embedder splits and sends embedding requests.
+type EmbedderConfig struct {
+	// MaxItems caps the number of inputs per request.
+	MaxItems int
+	// MaxTokens caps the estimated tokens per request. An input estimated above the cap is sent alone.
+	MaxTokens int
+	// Concurrency bounds the number of Embedding calls in flight.
+	Concurrency int
+	// TokenCounter estimates the tokens of one input. The default counts the runes of its text, which
+	// over-estimates for most languages, and charges a flat 1024 tokens per image and a whole default
+	// request per video. Supply a counter matching the model when its multimodal costs differ.
+	TokenCounter func(*model.EmbeddingData) int
+
+	// RequestOptions are applied to every Embedding call.
+	RequestOptions []RequestOption
+}
+
+// Embedder embeds inputs of any size by chunking them under the model limits, sending the chunks
+// concurrently and reassembling the vectors in input order.
+type Embedder struct {
+	client EmbeddingClient
+	config EmbedderConfig
+}
+
+// NewEmbedder constructs an Embedder sending through client.
+func NewEmbedder(client EmbeddingClient, config EmbedderConfig) *Embedder {
+	if config.MaxItems <= 0 {
+		config.MaxItems = defaultEmbedMaxItems
+	}
+	if config.MaxTokens <= 0 {
+		config.MaxTokens = defaultEmbedMaxTokens
+	}
+	if config.Concurrency <= 0 {
+		config.Concurrency = defaultEmbedConcurrency
+	}
+	if config.TokenCounter == nil {
+		config.TokenCounter = estimateEmbeddingTokens
+	}
+	return &Embedder{client: client, config: config}
+}
+
+type embedChunk struct {
+	start, end int
+}
+
+// Embed embeds request.Data, applying the request's models and project to every chunk. The response holds
+// one embedding per input in input order and the token usage summed over all chunks. The first failing
+// chunk cancels the others and its error is returned.
+func (e *Embedder) Embed(ctx context.Context, request model.EmbeddingRequest) (*model.EmbeddingResponse, error) {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	response := &model.EmbeddingResponse{Result: &model.EmbeddingResult{Data: make([]*model.Embedding, len(request.Data))}}
+	if len(request.Data) == 0 {
+		return response, nil
+	}
+	for i, data := range request.Data {
+		if data == nil {
+			return nil, model.NewInvalidParameterError(fmt.Sprintf("embedding input %d is nil", i))
+		}
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var (
+		wg       sync.WaitGroup
+		mu       sync.Mutex
+		firstErr error
+		usage    model.MapStr
+		slots    = make(chan struct{}, e.config.Concurrency)
+	)
+	for _, chunk := range e.split(request.Data) {
+		select {
+		case slots <- struct{}{}:
+		case <-ctx.Done():
+		}
+		if ctx.Err() != nil {
+			break
+		}
+
+		wg.Add(1)
+		go func(chunk embedChunk) {
+			defer wg.Done()
+			defer func() { <-slots }()
+
+			chunkRequest := request
+			chunkRequest.Data = request.Data[chunk.start:chunk.end]
+			resp, err := e.client.Embedding(ctx, chunkRequest, e.config.RequestOptions...)
+			if err == nil && (resp == nil || resp.Result == nil || len(resp.Result.Data) != chunk.end-chunk.start) {
+				got := 0
+				if resp != nil && resp.Result != nil {
+					got = len(resp.Result.Data)
+				}
+				err = model.NewErrorWithStatusCode(model.ErrCodeEmbeddingFailed,
+					fmt.Sprintf("embedding returned %d vectors for inputs %d-%d", got, chunk.start, chunk.end-1), http.StatusInternalServerError)
+			}
+
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				if firstErr == nil {
+					firstErr = err
+					cancel()
+				}
+				return
+			}
+			copy(response.Result.Data[chunk.start:chunk.end], resp.Result.Data)
+			usage = mergeTokenUsage(usage, resp.Result.TokenUsage)
+			if response.RequestID == "" {
+				response.CommonResponse = resp.CommonResponse
+			}
+		}(chunk)
+	}
+	wg.Wait()
+
+	if firstErr != nil {
+		return nil, firstErr
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	if usage != nil {
+		response.Result.TokenUsage = usage
+	}
+	return response, nil
+}
+
+// split groups consecutive inputs into chunks under MaxItems and MaxTokens.
+func (e *Embedder) split(data []*model.EmbeddingData) []embedChunk {
+	var chunks []embedChunk
+	start, tokens := 0, 0
+	for i, item := range data {
+		cost := e.config.TokenCounter(item)
+		if i > start && (i-start >= e.config.MaxItems || tokens+cost > e.config.MaxTokens) {
+			chunks = append(chunks, embedChunk{start: start, end: i})
+			start, tokens = i, 0
+		}
+		tokens += cost
+	}
+	return append(chunks, embedChunk{start: start, end: len(data)})
+}
+
+func estimateEmbeddingTokens(data *model.EmbeddingData) int {
+	tokens := 0
+	if data.Text != nil {
+		tokens += utf8.RuneCountInString(*data.Text)
+	}
+	if data.Image != nil {
+		tokens += embedImageTokens
+	}
+	if data.Video != nil {
+		tokens += embedVideoTokens
+	}
+	for _, part := range data.FullModalSeq {
+		if part.Text != nil {
+			tokens += utf8.RuneCountInString(*part.Text)
+		}
+		if part.Image != nil {
+			tokens += embedImageTokens
+		}
+		if part.Video != nil {
+			tokens += embedVideoTokens
+		}
+	}
+	return tokens
+}
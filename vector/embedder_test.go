@@ -0,0 +1,185 @@
+// Copyright (c) 2025 Beijing Volcano Engine Technology Co., Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package vector_test
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/volcengine/vikingdb-go-sdk/vector"
+	"github.com/volcengine/vikingdb-go-sdk/vector/fakes"
+	"github.com/volcengine/vikingdb-go-sdk/vector/model"
+)
+
+func textInput(s string) *model.EmbeddingData { return &model.EmbeddingData{Text: &s} }
+
+func imageInput() *model.EmbeddingData {
+	return &model.EmbeddingData{Image: "https://example.com/cat.png"}
+}
+
+func videoInput() *model.EmbeddingData {
+	video := "https://example.com/cat.mp4"
+	return &model.EmbeddingData{FullModalSeq: []model.FullModalData{{Video: video}}}
+}
+
+// indexedEmbeddingClient answers each input with a vector holding its position in inputs. Later chunks
+// answer first, so the Embedder has to reassemble them.
+func indexedEmbeddingClient(inputs []*model.EmbeddingData) (*fakes.EmbeddingClient, *[]int) {
+	position := make(map[*model.EmbeddingData]int, len(inputs))
+	for i, input := range inputs {
+		position[input] = i
+	}
+	var mu sync.Mutex
+	var sizes []int
+	client := &fakes.EmbeddingClient{
+		EmbeddingFunc: func(ctx context.Context, request model.EmbeddingRequest, opts ...vector.RequestOption) (*model.EmbeddingResponse, error) {
+			first := position[request.Data[0]]
+			time.Sleep(time.Duration(len(inputs)-first) * time.Millisecond)
+			result := &model.EmbeddingResult{TokenUsage: model.MapStr{"total_tokens": len(request.Data)}}
+			for _, data := range request.Data {
+				result.Data = append(result.Data, &model.Embedding{DenseVectors: []float32{float32(position[data])}})
+			}
+			mu.Lock()
+			sizes = append(sizes, len(request.Data))
+			mu.Unlock()
+			return &model.EmbeddingResponse{Result: result}, nil
+		},
+	}
+	return client, &sizes
+}
+
+func TestEmbedderReassemblesChunks(t *testing.T) {
+	repeat := func(n int, input func() *model.EmbeddingData) []*model.EmbeddingData {
+		out := make([]*model.EmbeddingData, n)
+		for i := range out {
+			out[i] = input()
+		}
+		return out
+	}
+	cases := []struct {
+		name      string
+		inputs    []*model.EmbeddingData
+		config    vector.EmbedderConfig
+		wantSizes []int
+	}{
+		{
+			name:      "item cap",
+			inputs:    repeat(10, func() *model.EmbeddingData { return textInput("hi") }),
+			config:    vector.EmbedderConfig{MaxItems: 3},
+			wantSizes: []int{1, 3, 3, 3},
+		},
+		{
+			name:      "token cap",
+			inputs:    repeat(5, func() *model.EmbeddingData { return textInput("0123456789") }),
+			config:    vector.EmbedderConfig{MaxTokens: 25},
+			wantSizes: []int{1, 2, 2},
+		},
+		{
+			name:      "images count toward the token cap",
+			inputs:    repeat(4, imageInput),
+			config:    vector.EmbedderConfig{MaxTokens: 2048},
+			wantSizes: []int{2, 2},
+		},
+		{
+			name:      "a video fills a default request on its own",
+			inputs:    []*model.EmbeddingData{textInput("a"), videoInput(), textInput("b")},
+			wantSizes: []int{1, 1, 1},
+		},
+		{
+			name:      "custom token counter",
+			inputs:    repeat(6, videoInput),
+			config:    vector.EmbedderConfig{MaxTokens: 25, TokenCounter: func(*model.EmbeddingData) int { return 10 }},
+			wantSizes: []int{2, 2, 2},
+		},
+		{
+			name:      "one request when everything fits",
+			inputs:    repeat(4, func() *model.EmbeddingData { return textInput("hi") }),
+			wantSizes: []int{4},
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			client, sizes := indexedEmbeddingClient(tc.inputs)
+			resp, err := vector.NewEmbedder(client, tc.config).Embed(context.Background(), model.EmbeddingRequest{Data: tc.inputs})
+			if err != nil {
+				t.Fatal(err)
+			}
+			for i, embedding := range resp.Result.Data {
+				if embedding == nil || embedding.DenseVectors[0] != float32(i) {
+					t.Fatalf("embedding %d = %+v, want the vector of input %d", i, embedding, i)
+				}
+			}
+			got := append([]int(nil), *sizes...)
+			sort.Ints(got)
+			if !reflect.DeepEqual(got, tc.wantSizes) {
+				t.Fatalf("chunk sizes = %v, want %v", got, tc.wantSizes)
+			}
+			usage, _ := resp.Result.TokenUsage.(model.MapStr)
+			if total, _ := usage["total_tokens"].(int64); int(total) != len(tc.inputs) {
+				t.Fatalf("token usage = %v, want the sum over chunks", resp.Result.TokenUsage)
+			}
+		})
+	}
+}
+
+func TestEmbedderErrors(t *testing.T) {
+	boom := model.NewServiceUnavailableError("down")
+	inputs := []*model.EmbeddingData{textInput("a"), textInput("b"), textInput("c")}
+	cases := []struct {
+		name     string
+		inputs   []*model.EmbeddingData
+		respond  func(request model.EmbeddingRequest) (*model.EmbeddingResponse, error)
+		wantErr  error
+		wantCode model.ErrorCode
+	}{
+		{
+			name:   "failing chunk",
+			inputs: inputs,
+			respond: func(request model.EmbeddingRequest) (*model.EmbeddingResponse, error) {
+				if *request.Data[0].Text == "b" {
+					return nil, boom
+				}
+				return &model.EmbeddingResponse{Result: &model.EmbeddingResult{Data: []*model.Embedding{{}}}}, nil
+			},
+			wantErr: boom,
+		},
+		{
+			name:   "short response",
+			inputs: inputs,
+			respond: func(model.EmbeddingRequest) (*model.EmbeddingResponse, error) {
+				return &model.EmbeddingResponse{Result: &model.EmbeddingResult{}}, nil
+			},
+			wantCode: model.ErrCodeEmbeddingFailed,
+		},
+		{
+			name:     "nil input",
+			inputs:   []*model.EmbeddingData{textInput("a"), nil},
+			wantCode: model.ErrCodeInvalidParameter,
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			client := &fakes.EmbeddingClient{
+				EmbeddingFunc: func(ctx context.Context, request model.EmbeddingRequest, opts ...vector.RequestOption) (*model.EmbeddingResponse, error) {
+					return tc.respond(request)
+				},
+			}
+			_, err := vector.NewEmbedder(client, vector.EmbedderConfig{MaxItems: 1}).Embed(context.Background(), model.EmbeddingRequest{Data: tc.inputs})
+			if tc.wantErr != nil && err != tc.wantErr {
+				t.Fatalf("err = %v, want %v", err, tc.wantErr)
+			}
+			if tc.wantCode != "" {
+				var sdkErr *model.Error
+				if !errors.As(err, &sdkErr) || sdkErr.Code != tc.wantCode {
+					t.Fatalf("err = %v, want code %s", err, tc.wantCode)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,218 @@
+// Copyright (c) 2025 Beijing Volcano Engine Technology Co., Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package vector
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"sync"
+	"time"
+
+	"github.com/volcengine/vikingdb-go-sdk/vector/model"
+)
+
+const defaultHealthCheckTimeout = 5 * time.Second
+
+// EndpointConfig describes one VikingDB endpoint and the region its requests are signed for.
+type EndpointConfig struct {
+	Endpoint string
+	// Region defaults to Config.Region when empty.
+	Region string
+}
+
+// FailoverPolicy selects how requests are spread across multiple endpoints.
+type FailoverPolicy int
+
+const (
+	// FailoverSticky keeps using the current endpoint until it fails, then moves to the next healthy one.
+	FailoverSticky FailoverPolicy = iota
+	// FailoverPriority always uses the first healthy endpoint in configuration order, so traffic returns
+	// to the primary once health probes report it healthy again.
+	FailoverPriority
+	// FailoverRoundRobin rotates requests across all healthy endpoints.
+	FailoverRoundRobin
+)
+
+// WithEndpoints configures an ordered list of endpoints, replacing Endpoint and Region. Requests fail over to
+// the next endpoint on connection errors and 5xx replies, within the configured retry budget.
+func WithEndpoints(endpoints ...EndpointConfig) ClientOption {
+	return func(c *Config) {
+		c.Endpoints = append([]EndpointConfig(nil), endpoints...)
+	}
+}
+
+// WithFailoverPolicy selects how requests are spread across the endpoints configured via WithEndpoints.
+func WithFailoverPolicy(policy FailoverPolicy) ClientOption {
+	return func(c *Config) {
+		c.FailoverPolicy = policy
+	}
+}
+
+// WithHealthCheck probes every endpoint with a GET on path at the given interval and marks it unhealthy on
+// connection errors or 5xx replies. Probing stops when the client is closed.
+func WithHealthCheck(interval time.Duration, path string) ClientOption {
+	return func(c *Config) {
+		c.HealthCheckInterval = interval
+		c.HealthCheckPath = path
+	}
+}
+
+type endpoint struct {
+	baseURL *url.URL
+	region  string
+	healthy bool
+}
+
+// endpointPool tracks endpoint health and picks the endpoint for each attempt.
+type endpointPool struct {
+	policy FailoverPolicy
+
+	mu        sync.Mutex
+	endpoints []*endpoint
+	current   int
+	// next is where the round-robin scan for the following attempt starts.
+	next int
+
+	stop chan struct{}
+	once sync.Once
+}
+
+func newEndpointPool(cfg Config) (*endpointPool, error) {
+	configs := cfg.Endpoints
+	if len(configs) == 0 {
+		configs = []EndpointConfig{{Endpoint: cfg.Endpoint, Region: cfg.Region}}
+	}
+
+	pool := &endpointPool{policy: cfg.FailoverPolicy, stop: make(chan struct{})}
+	for _, ec := range configs {
+		if ec.Endpoint == "" {
+			return nil, model.NewInvalidParameterError("endpoint cannot be empty")
+		}
+		baseURL, err := url.Parse(ec.Endpoint)
+		if err != nil {
+			return nil, model.NewErrorWithCause(model.ErrCodeInvalidParameter, "invalid endpoint", err, http.StatusBadRequest)
+		}
+		if baseURL.Scheme == "" {
+			baseURL.Scheme = "https"
+		}
+		region := ec.Region
+		if region == "" {
+			region = cfg.Region
+		}
+		pool.endpoints = append(pool.endpoints, &endpoint{baseURL: baseURL, region: region, healthy: true})
+	}
+	return pool, nil
+}
+
+// pick returns the index of the endpoint to use for the next attempt.
+func (p *endpointPool) pick() (int, *endpoint) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	n := len(p.endpoints)
+	switch p.policy {
+	case FailoverPriority:
+		p.current = p.firstHealthyLocked(0)
+	case FailoverRoundRobin:
+		p.current = p.firstHealthyLocked(p.next)
+		p.next = (p.current + 1) % n
+	default:
+		if !p.endpoints[p.current].healthy {
+			p.current = p.firstHealthyLocked(p.current)
+		}
+	}
+	return p.current, p.endpoints[p.current]
+}
+
+// firstHealthyLocked scans from start; when nothing is healthy it returns start so requests keep flowing.
+func (p *endpointPool) firstHealthyLocked(start int) int {
+	n := len(p.endpoints)
+	for i := 0; i < n; i++ {
+		idx := (start + i) % n
+		if p.endpoints[idx].healthy {
+			return idx
+		}
+	}
+	return start
+}
+
+// report records the outcome of an attempt against the endpoint at idx.
+func (p *endpointPool) report(idx int, err error) {
+	if len(p.endpoints) < 2 {
+		return
+	}
+	unhealthy := false
+	if sdkErr, ok := err.(*model.Error); ok {
+		unhealthy = sdkErr.Code == model.ErrCodeHTTPRequestFailed || sdkErr.StatusCode >= http.StatusInternalServerError
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if unhealthy {
+		p.endpoints[idx].healthy = false
+		if p.current == idx && p.policy == FailoverSticky {
+			p.current = p.firstHealthyLocked((idx + 1) % len(p.endpoints))
+		}
+	} else if err == nil {
+		p.endpoints[idx].healthy = true
+	}
+}
+
+// reflectsEndpointHealth reports whether an attempt outcome says anything about the endpoint. A cancelled
+// caller, a losing hedge and an open circuit breaker fail without the endpoint being at fault.
+func reflectsEndpointHealth(ctx context.Context, err error) bool {
+	if ctx.Err() != nil {
+		return false
+	}
+	sdkErr, ok := err.(*model.Error)
+	return !ok || sdkErr.Code != model.ErrCodeCircuitOpen
+}
+
+// startHealthChecks probes every endpoint periodically until close is called.
+func (p *endpointPool) startHealthChecks(client *http.Client, interval time.Duration, path string) {
+	if interval <= 0 || len(p.endpoints) < 2 {
+		return
+	}
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-p.stop:
+				return
+			case <-ticker.C:
+				for idx, ep := range p.endpoints {
+					healthy := probeEndpoint(client, ep.baseURL, path, interval)
+					p.mu.Lock()
+					p.endpoints[idx].healthy = healthy
+					p.mu.Unlock()
+				}
+			}
+		}
+	}()
+}
+
+func (p *endpointPool) close() {
+	p.once.Do(func() { close(p.stop) })
+}
+
+func probeEndpoint(client *http.Client, baseURL *url.URL, path string, interval time.Duration) bool {
+	timeout := defaultHealthCheckTimeout
+	if interval < timeout {
+		timeout = interval
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	target := baseURL.ResolveReference(&url.URL{Path: path})
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, target.String(), nil)
+	if err != nil {
+		return false
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return false
+	}
+	resp.Body.Close()
+	return resp.StatusCode < http.StatusInternalServerError
+}
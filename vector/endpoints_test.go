@@ -0,0 +1,211 @@
+// Copyright (c) 2025 Beijing Volcano Engine Technology Co., Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package vector
+
+import (
+	"context"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/volcengine/vikingdb-go-sdk/vector/model"
+	"github.com/volcengine/vikingdb-go-sdk/vector/utils"
+)
+
+func TestEndpointPoolPick(t *testing.T) {
+	cases := []struct {
+		name      string
+		policy    FailoverPolicy
+		unhealthy []int
+		want      []int
+	}{
+		{name: "sticky stays on the first endpoint", policy: FailoverSticky, want: []int{0, 0, 0}},
+		{name: "sticky skips an unhealthy endpoint", policy: FailoverSticky, unhealthy: []int{0}, want: []int{1, 1, 1}},
+		{name: "priority prefers the first healthy endpoint", policy: FailoverPriority, unhealthy: []int{0}, want: []int{1, 1}},
+		{name: "round robin starts at the first endpoint", policy: FailoverRoundRobin, want: []int{0, 1, 2, 0}},
+		{name: "round robin skips unhealthy endpoints", policy: FailoverRoundRobin, unhealthy: []int{1}, want: []int{0, 2, 0, 2}},
+		{name: "nothing healthy keeps requests flowing", policy: FailoverPriority, unhealthy: []int{0, 1, 2}, want: []int{0, 0}},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			pool, err := newEndpointPool(Config{
+				Endpoints:      []EndpointConfig{{Endpoint: "http://a"}, {Endpoint: "http://b"}, {Endpoint: "http://c"}},
+				FailoverPolicy: tc.policy,
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+			for _, idx := range tc.unhealthy {
+				pool.endpoints[idx].healthy = false
+			}
+			for i, want := range tc.want {
+				if got, _ := pool.pick(); got != want {
+					t.Fatalf("pick %d = %d, want %d", i, got, want)
+				}
+			}
+		})
+	}
+}
+
+func TestEndpointFailover(t *testing.T) {
+	var primaryHits, secondaryHits int32
+	primary := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&primaryHits, 1)
+		w.WriteHeader(http.StatusServiceUnavailable)
+		_, _ = w.Write([]byte(`{"code":"ServiceUnavailable","message":"down"}`))
+	}))
+	defer primary.Close()
+	secondary := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&secondaryHits, 1)
+		_, _ = w.Write([]byte(searchReply))
+	}))
+	defer secondary.Close()
+
+	client, err := New(AuthAPIKey("k"),
+		WithEndpoints(EndpointConfig{Endpoint: primary.URL}, EndpointConfig{Endpoint: secondary.URL}),
+		WithMaxRetries(1),
+		WithRetryPolicy(utils.RetryPolicy{InitialBackoff: time.Millisecond, MaxBackoff: time.Millisecond, Jitter: utils.JitterNone}),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer client.Close()
+	index := client.Index(model.IndexLocator{CollectionLocator: model.CollectionLocator{CollectionName: "c"}, IndexName: "i"})
+
+	for i := 0; i < 2; i++ {
+		if _, err := index.SearchByVector(context.Background(), model.SearchByVectorRequest{DenseVector: []float64{1}}); err != nil {
+			t.Fatalf("call %d: %v", i, err)
+		}
+	}
+	// The first call fails over within its retry budget; the second goes straight to the secondary.
+	if primaryHits != 1 || secondaryHits != 2 {
+		t.Fatalf("primary saw %d requests, secondary %d; want 1 and 2", primaryHits, secondaryHits)
+	}
+}
+
+func TestHealthProbesBypassCassette(t *testing.T) {
+	var probes int32
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = io.Copy(ioutil.Discard, r.Body)
+		if r.URL.Path == "/health" {
+			atomic.AddInt32(&probes, 1)
+		}
+	})
+	a, b := httptest.NewServer(handler), httptest.NewServer(handler)
+	defer a.Close()
+	defer b.Close()
+
+	path := filepath.Join(t.TempDir(), "cassette.json")
+	if err := ioutil.WriteFile(path, []byte(`{"interactions":[]}`), 0600); err != nil {
+		t.Fatal(err)
+	}
+	cassette, err := NewCassette(path, CassetteReplay)
+	if err != nil {
+		t.Fatal(err)
+	}
+	client, err := New(AuthAPIKey("k"),
+		WithEndpoints(EndpointConfig{Endpoint: a.URL}, EndpointConfig{Endpoint: b.URL}),
+		WithCassette(cassette),
+		WithHealthCheck(5*time.Millisecond, "/health"),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer client.Close()
+
+	deadline := time.Now().Add(2 * time.Second)
+	for atomic.LoadInt32(&probes) < 2 {
+		if time.Now().After(deadline) {
+			t.Fatal("health probes never reached the endpoints")
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+	if n := len(cassette.Interactions()); n != 0 {
+		t.Fatalf("cassette holds %d interactions, want probes kept out of it", n)
+	}
+}
+
+func TestEndpointHealthIgnoresAbortedAttempts(t *testing.T) {
+	circuitOpen := func(context.Context, *CallInfo, *http.Request, Invoker) error {
+		return model.NewCircuitOpenError("open")
+	}
+	cases := []struct {
+		name      string
+		options   []ClientOption
+		callOpts  []RequestOption
+		timeout   time.Duration
+		wantErr   bool
+		wantAbort bool
+	}{
+		{name: "cancelled caller", timeout: 20 * time.Millisecond, wantErr: true, wantAbort: true},
+		{name: "losing hedge", callOpts: []RequestOption{WithRequestHedging(HedgePolicy{Delay: 20 * time.Millisecond})}, wantAbort: true},
+		{name: "open circuit breaker", options: []ClientOption{WithInterceptors(circuitOpen)}, wantErr: true},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			var requests int32
+			aborted := make(chan struct{}, 1)
+			primary := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_, _ = io.Copy(ioutil.Discard, r.Body)
+				// The first request hangs until the client gives up on it.
+				if atomic.AddInt32(&requests, 1) == 1 {
+					<-r.Context().Done()
+					aborted <- struct{}{}
+					return
+				}
+				_, _ = w.Write([]byte(searchReply))
+			}))
+			defer primary.Close()
+			secondary := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_, _ = io.Copy(ioutil.Discard, r.Body)
+				_, _ = w.Write([]byte(searchReply))
+			}))
+			defer secondary.Close()
+
+			opts := append([]ClientOption{
+				WithEndpoints(EndpointConfig{Endpoint: primary.URL}, EndpointConfig{Endpoint: secondary.URL}),
+				WithMaxRetries(0),
+			}, tc.options...)
+			client, err := New(AuthAPIKey("k"), opts...)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer client.Close()
+
+			ctx := context.Background()
+			if tc.timeout > 0 {
+				var cancel context.CancelFunc
+				ctx, cancel = context.WithTimeout(ctx, tc.timeout)
+				defer cancel()
+			}
+			index := client.Index(model.IndexLocator{CollectionLocator: model.CollectionLocator{CollectionName: "c"}, IndexName: "i"})
+			_, err = index.SearchByVector(ctx, model.SearchByVectorRequest{DenseVector: []float64{1}}, tc.callOpts...)
+			if tc.wantErr != (err != nil) {
+				t.Fatalf("err = %v, want error %v", err, tc.wantErr)
+			}
+			if tc.wantAbort {
+				select {
+				case <-aborted:
+				case <-time.After(2 * time.Second):
+					t.Fatal("the hanging request was never aborted")
+				}
+				// Give the aborted attempt time to report its outcome.
+				time.Sleep(20 * time.Millisecond)
+			}
+
+			pool := client.transport.endpoints
+			pool.mu.Lock()
+			healthy := pool.endpoints[0].healthy
+			pool.mu.Unlock()
+			if !healthy {
+				t.Fatal("the primary endpoint was marked unhealthy")
+			}
+		})
+	}
+}
@@ -0,0 +1,133 @@
+// Copyright (c) 2025 Beijing Volcano Engine Technology Co., Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package fakes
+
+import (
+	"context"
+
+	"github.com/volcengine/vikingdb-go-sdk/vector"
+	"github.com/volcengine/vikingdb-go-sdk/vector/model"
+)
+
+// CollectionClient is a configurable vector.CollectionClient.
+type CollectionClient struct {
+	Recorder
+	Locator model.CollectionLocator
+
+	UpsertFunc func(ctx context.Context, request model.UpsertDataRequest, opts ...vector.RequestOption) (*model.UpsertDataResponse, error)
+	UpdateFunc func(ctx context.Context, request model.UpdateDataRequest, opts ...vector.RequestOption) (*model.UpdateDataResponse, error)
+	DeleteFunc func(ctx context.Context, request model.DeleteDataRequest, opts ...vector.RequestOption) (*model.DeleteDataResponse, error)
+	FetchFunc  func(ctx context.Context, request model.FetchDataInCollectionRequest, opts ...vector.RequestOption) (*model.FetchDataInCollectionResponse, error)
+}
+
+var _ vector.CollectionClient = (*CollectionClient)(nil)
+
+// Upsert records the call and delegates to UpsertFunc.
+func (f *CollectionClient) Upsert(ctx context.Context, request model.UpsertDataRequest, opts ...vector.RequestOption) (*model.UpsertDataResponse, error) {
+	f.record("Upsert", request, opts)
+	if f.UpsertFunc != nil {
+		return f.UpsertFunc(ctx, request, opts...)
+	}
+	return &model.UpsertDataResponse{Result: &model.UpsertDataResult{}}, nil
+}
+
+// Update records the call and delegates to UpdateFunc.
+func (f *CollectionClient) Update(ctx context.Context, request model.UpdateDataRequest, opts ...vector.RequestOption) (*model.UpdateDataResponse, error) {
+	f.record("Update", request, opts)
+	if f.UpdateFunc != nil {
+		return f.UpdateFunc(ctx, request, opts...)
+	}
+	return &model.UpdateDataResponse{Result: &model.UpdateDataResult{}}, nil
+}
+
+// Delete records the call and delegates to DeleteFunc.
+func (f *CollectionClient) Delete(ctx context.Context, request model.DeleteDataRequest, opts ...vector.RequestOption) (*model.DeleteDataResponse, error) {
+	f.record("Delete", request, opts)
+	if f.DeleteFunc != nil {
+		return f.DeleteFunc(ctx, request, opts...)
+	}
+	return &model.DeleteDataResponse{}, nil
+}
+
+// Fetch records the call and delegates to FetchFunc.
+func (f *CollectionClient) Fetch(ctx context.Context, request model.FetchDataInCollectionRequest, opts ...vector.RequestOption) (*model.FetchDataInCollectionResponse, error) {
+	f.record("Fetch", request, opts)
+	if f.FetchFunc != nil {
+		return f.FetchFunc(ctx, request, opts...)
+	}
+	return &model.FetchDataInCollectionResponse{Result: &model.FetchDataInCollectionResult{}}, nil
+}
+
+// CollectionName returns the locator's collection name.
+func (f *CollectionClient) CollectionName() string {
+	return f.Locator.CollectionName
+}
+
+// ResourceID returns the locator's resource ID.
+func (f *CollectionClient) ResourceID() string {
+	return f.Locator.ResourceID
+}
+
+// ProjectName returns the locator's project name.
+func (f *CollectionClient) ProjectName() string {
+	return f.Locator.ProjectName
+}
+
+// CollectionAdminClient is a configurable vector.CollectionAdminClient.
+type CollectionAdminClient struct {
+	Recorder
+
+	CreateCollectionFunc   func(ctx context.Context, request model.CreateCollectionRequest, opts ...vector.RequestOption) (*model.CreateCollectionResponse, error)
+	DescribeCollectionFunc func(ctx context.Context, request model.DescribeCollectionRequest, opts ...vector.RequestOption) (*model.DescribeCollectionResponse, error)
+	ListCollectionsFunc    func(ctx context.Context, request model.ListCollectionsRequest, opts ...vector.RequestOption) (*model.ListCollectionsResponse, error)
+	UpdateCollectionFunc   func(ctx context.Context, request model.UpdateCollectionRequest, opts ...vector.RequestOption) (*model.UpdateCollectionResponse, error)
+	DropCollectionFunc     func(ctx context.Context, request model.DropCollectionRequest, opts ...vector.RequestOption) (*model.DropCollectionResponse, error)
+}
+
+var _ vector.CollectionAdminClient = (*CollectionAdminClient)(nil)
+
+// CreateCollection records the call and delegates to CreateCollectionFunc.
+func (f *CollectionAdminClient) CreateCollection(ctx context.Context, request model.CreateCollectionRequest, opts ...vector.RequestOption) (*model.CreateCollectionResponse, error) {
+	f.record("CreateCollection", request, opts)
+	if f.CreateCollectionFunc != nil {
+		return f.CreateCollectionFunc(ctx, request, opts...)
+	}
+	return &model.CreateCollectionResponse{Collection: &model.Collection{}}, nil
+}
+
+// DescribeCollection records the call and delegates to DescribeCollectionFunc.
+func (f *CollectionAdminClient) DescribeCollection(ctx context.Context, request model.DescribeCollectionRequest, opts ...vector.RequestOption) (*model.DescribeCollectionResponse, error) {
+	f.record("DescribeCollection", request, opts)
+	if f.DescribeCollectionFunc != nil {
+		return f.DescribeCollectionFunc(ctx, request, opts...)
+	}
+	return &model.DescribeCollectionResponse{Collection: &model.Collection{}}, nil
+}
+
+// ListCollections records the call and delegates to ListCollectionsFunc.
+func (f *CollectionAdminClient) ListCollections(ctx context.Context, request model.ListCollectionsRequest, opts ...vector.RequestOption) (*model.ListCollectionsResponse, error) {
+	f.record("ListCollections", request, opts)
+	if f.ListCollectionsFunc != nil {
+		return f.ListCollectionsFunc(ctx, request, opts...)
+	}
+	return &model.ListCollectionsResponse{}, nil
+}
+
+// UpdateCollection records the call and delegates to UpdateCollectionFunc.
+func (f *CollectionAdminClient) UpdateCollection(ctx context.Context, request model.UpdateCollectionRequest, opts ...vector.RequestOption) (*model.UpdateCollectionResponse, error) {
+	f.record("UpdateCollection", request, opts)
+	if f.UpdateCollectionFunc != nil {
+		return f.UpdateCollectionFunc(ctx, request, opts...)
+	}
+	return &model.UpdateCollectionResponse{Collection: &model.Collection{}}, nil
+}
+
+// DropCollection records the call and delegates to DropCollectionFunc.
+func (f *CollectionAdminClient) DropCollection(ctx context.Context, request model.DropCollectionRequest, opts ...vector.RequestOption) (*model.DropCollectionResponse, error) {
+	f.record("DropCollection", request, opts)
+	if f.DropCollectionFunc != nil {
+		return f.DropCollectionFunc(ctx, request, opts...)
+	}
+	return &model.DropCollectionResponse{}, nil
+}
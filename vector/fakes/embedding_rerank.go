@@ -0,0 +1,47 @@
+// Copyright (c) 2025 Beijing Volcano Engine Technology Co., Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package fakes
+
+import (
+	"context"
+
+	"github.com/volcengine/vikingdb-go-sdk/vector"
+	"github.com/volcengine/vikingdb-go-sdk/vector/model"
+)
+
+// EmbeddingClient is a configurable vector.EmbeddingClient.
+type EmbeddingClient struct {
+	Recorder
+
+	EmbeddingFunc func(ctx context.Context, request model.EmbeddingRequest, opts ...vector.RequestOption) (*model.EmbeddingResponse, error)
+}
+
+var _ vector.EmbeddingClient = (*EmbeddingClient)(nil)
+
+// Embedding records the call and delegates to EmbeddingFunc.
+func (f *EmbeddingClient) Embedding(ctx context.Context, request model.EmbeddingRequest, opts ...vector.RequestOption) (*model.EmbeddingResponse, error) {
+	f.record("Embedding", request, opts)
+	if f.EmbeddingFunc != nil {
+		return f.EmbeddingFunc(ctx, request, opts...)
+	}
+	return &model.EmbeddingResponse{Result: &model.EmbeddingResult{}}, nil
+}
+
+// RerankClient is a configurable vector.RerankClient.
+type RerankClient struct {
+	Recorder
+
+	RerankFunc func(ctx context.Context, request model.RerankRequest, opts ...vector.RequestOption) (*model.RerankResponse, error)
+}
+
+var _ vector.RerankClient = (*RerankClient)(nil)
+
+// Rerank records the call and delegates to RerankFunc.
+func (f *RerankClient) Rerank(ctx context.Context, request model.RerankRequest, opts ...vector.RequestOption) (*model.RerankResponse, error) {
+	f.record("Rerank", request, opts)
+	if f.RerankFunc != nil {
+		return f.RerankFunc(ctx, request, opts...)
+	}
+	return &model.RerankResponse{Result: &model.RerankResult{}}, nil
+}
@@ -0,0 +1,197 @@
+// Copyright (c) 2025 Beijing Volcano Engine Technology Co., Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package fakes provides configurable in-memory implementations of the vector client interfaces.
+//
+// Every method records its call and delegates to the matching function field, e.g. UpsertFunc. Methods
+// whose function field is nil return a response with an empty, non-nil Result (or Collection/Index for
+// the admin clients) and no error, so callers can dereference it like a real reply.
+//
+//	index := &fakes.IndexClient{
+//		SearchByVectorFunc: func(ctx context.Context, req model.SearchByVectorRequest, opts ...vector.RequestOption) (*model.SearchResponse, error) {
+//			return &model.SearchResponse{Result: &model.SearchResult{}}, nil
+//		},
+//	}
+//	client := &fakes.Client{}
+//	client.SetIndex(locator, index)
+package fakes
+
+import (
+	"sync"
+
+	"github.com/volcengine/vikingdb-go-sdk/vector"
+	"github.com/volcengine/vikingdb-go-sdk/vector/model"
+)
+
+// Call is one recorded method invocation.
+type Call struct {
+	Method  string
+	Request interface{}
+	Options []vector.RequestOption
+}
+
+// Recorder keeps the calls made on a fake. The zero value is ready to use.
+type Recorder struct {
+	mu    sync.Mutex
+	calls []Call
+}
+
+func (r *Recorder) record(method string, request interface{}, opts []vector.RequestOption) {
+	r.mu.Lock()
+	r.calls = append(r.calls, Call{Method: method, Request: request, Options: opts})
+	r.mu.Unlock()
+}
+
+// Calls returns every recorded call in order.
+func (r *Recorder) Calls() []Call {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]Call(nil), r.calls...)
+}
+
+// CallsTo returns the recorded calls of one method.
+func (r *Recorder) CallsTo(method string) []Call {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var out []Call
+	for _, call := range r.calls {
+		if call.Method == method {
+			out = append(out, call)
+		}
+	}
+	return out
+}
+
+// Reset forgets the recorded calls.
+func (r *Recorder) Reset() {
+	r.mu.Lock()
+	r.calls = nil
+	r.mu.Unlock()
+}
+
+// Client is a configurable vector.API. Collection and Index return one fake per locator, creating it on
+// first use, so tests can configure a fake up front with SetCollection/SetIndex or inspect it afterwards.
+type Client struct {
+	CollectionAdminClient CollectionAdminClient
+	IndexAdminClient      IndexAdminClient
+	EmbeddingClient       EmbeddingClient
+	RerankClient          RerankClient
+
+	// CloseFunc, when set, is returned by Close.
+	CloseFunc func() error
+
+	mu          sync.Mutex
+	collections map[model.CollectionLocator]*CollectionClient
+	indexes     map[model.IndexLocator]*IndexClient
+	closed      bool
+}
+
+var _ vector.API = (*Client)(nil)
+
+// SetCollection registers the fake returned for base; a nil fake registers a fresh one. It works on the
+// zero Client.
+func (c *Client) SetCollection(base model.CollectionLocator, fake *CollectionClient) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.collections == nil {
+		c.collections = make(map[model.CollectionLocator]*CollectionClient)
+	}
+	if fake == nil {
+		fake = &CollectionClient{}
+	}
+	fake.Locator = base
+	c.collections[base] = fake
+}
+
+// SetIndex registers the fake returned for base; a nil fake registers a fresh one. It works on the zero
+// Client.
+func (c *Client) SetIndex(base model.IndexLocator, fake *IndexClient) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.indexes == nil {
+		c.indexes = make(map[model.IndexLocator]*IndexClient)
+	}
+	if fake == nil {
+		fake = &IndexClient{}
+	}
+	fake.Locator = base
+	c.indexes[base] = fake
+}
+
+// CollectionFake returns the fake for base, creating it when needed.
+func (c *Client) CollectionFake(base model.CollectionLocator) *CollectionClient {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if fake, ok := c.collections[base]; ok {
+		return fake
+	}
+	if c.collections == nil {
+		c.collections = make(map[model.CollectionLocator]*CollectionClient)
+	}
+	fake := &CollectionClient{Locator: base}
+	c.collections[base] = fake
+	return fake
+}
+
+// IndexFake returns the fake for base, creating it when needed.
+func (c *Client) IndexFake(base model.IndexLocator) *IndexClient {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if fake, ok := c.indexes[base]; ok {
+		return fake
+	}
+	if c.indexes == nil {
+		c.indexes = make(map[model.IndexLocator]*IndexClient)
+	}
+	fake := &IndexClient{Locator: base}
+	c.indexes[base] = fake
+	return fake
+}
+
+// Collection returns the fake for base.
+func (c *Client) Collection(base model.CollectionLocator) vector.CollectionClient {
+	return c.CollectionFake(base)
+}
+
+// CollectionAdmin returns the CollectionAdminClient field.
+func (c *Client) CollectionAdmin() vector.CollectionAdminClient {
+	return &c.CollectionAdminClient
+}
+
+// Index returns the fake for base.
+func (c *Client) Index(base model.IndexLocator) vector.IndexClient {
+	return c.IndexFake(base)
+}
+
+// IndexAdmin returns the IndexAdminClient field.
+func (c *Client) IndexAdmin() vector.IndexAdminClient {
+	return &c.IndexAdminClient
+}
+
+// Embedding returns the EmbeddingClient field.
+func (c *Client) Embedding() vector.EmbeddingClient {
+	return &c.EmbeddingClient
+}
+
+// Rerank returns the RerankClient field.
+func (c *Client) Rerank() vector.RerankClient {
+	return &c.RerankClient
+}
+
+// Close marks the client closed and returns CloseFunc's result.
+func (c *Client) Close() error {
+	c.mu.Lock()
+	c.closed = true
+	c.mu.Unlock()
+	if c.CloseFunc != nil {
+		return c.CloseFunc()
+	}
+	return nil
+}
+
+// Closed reports whether Close was called.
+func (c *Client) Closed() bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.closed
+}
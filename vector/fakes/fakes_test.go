@@ -0,0 +1,112 @@
+// Copyright (c) 2025 Beijing Volcano Engine Technology Co., Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package fakes_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/volcengine/vikingdb-go-sdk/vector"
+	"github.com/volcengine/vikingdb-go-sdk/vector/fakes"
+	"github.com/volcengine/vikingdb-go-sdk/vector/model"
+)
+
+func TestUnstubbedMethodsReturnResults(t *testing.T) {
+	ctx := context.Background()
+	client := &fakes.Client{}
+	collection := client.Collection(model.CollectionLocator{CollectionName: "c"})
+	index := client.Index(model.IndexLocator{IndexName: "i"})
+
+	cases := []struct {
+		name string
+		call func() (bool, error)
+	}{
+		{name: "Upsert", call: func() (bool, error) {
+			r, err := collection.Upsert(ctx, model.UpsertDataRequest{})
+			return r.Result != nil, err
+		}},
+		{name: "Update", call: func() (bool, error) {
+			r, err := collection.Update(ctx, model.UpdateDataRequest{})
+			return r.Result != nil, err
+		}},
+		{name: "Fetch in collection", call: func() (bool, error) {
+			r, err := collection.Fetch(ctx, model.FetchDataInCollectionRequest{})
+			return r.Result != nil, err
+		}},
+		{name: "Fetch in index", call: func() (bool, error) {
+			r, err := index.Fetch(ctx, model.FetchDataInIndexRequest{})
+			return r.Result != nil, err
+		}},
+		{name: "SearchByVector", call: func() (bool, error) {
+			r, err := index.SearchByVector(ctx, model.SearchByVectorRequest{})
+			return r.Result != nil, err
+		}},
+		{name: "SearchByScalar", call: func() (bool, error) {
+			r, err := index.SearchByScalar(ctx, model.SearchByScalarRequest{})
+			return r.Result != nil, err
+		}},
+		{name: "Aggregate", call: func() (bool, error) {
+			r, err := index.Aggregate(ctx, model.AggRequest{})
+			return r.Result != nil, err
+		}},
+		{name: "Embedding", call: func() (bool, error) {
+			r, err := client.Embedding().Embedding(ctx, model.EmbeddingRequest{})
+			return r.Result != nil, err
+		}},
+		{name: "Rerank", call: func() (bool, error) {
+			r, err := client.Rerank().Rerank(ctx, model.RerankRequest{})
+			return r.Result != nil, err
+		}},
+		{name: "DescribeCollection", call: func() (bool, error) {
+			r, err := client.CollectionAdmin().DescribeCollection(ctx, model.DescribeCollectionRequest{})
+			return r.Collection != nil, err
+		}},
+		{name: "GetIndex", call: func() (bool, error) {
+			r, err := client.IndexAdmin().GetIndex(ctx, model.GetIndexRequest{})
+			return r.Index != nil, err
+		}},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			ok, err := tc.call()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !ok {
+				t.Fatal("the default response has a nil result")
+			}
+		})
+	}
+}
+
+func TestClientRegistersFakes(t *testing.T) {
+	locator := model.IndexLocator{CollectionLocator: model.CollectionLocator{CollectionName: "c"}, IndexName: "i"}
+	wantErr := errors.New("boom")
+
+	var client fakes.Client
+	client.SetCollection(model.CollectionLocator{CollectionName: "nil fake"}, nil)
+	client.SetIndex(locator, &fakes.IndexClient{
+		SearchByVectorFunc: func(context.Context, model.SearchByVectorRequest, ...vector.RequestOption) (*model.SearchResponse, error) {
+			return nil, wantErr
+		},
+	})
+
+	if got := client.CollectionFake(model.CollectionLocator{CollectionName: "nil fake"}); got == nil || got.CollectionName() != "nil fake" {
+		t.Fatalf("nil fake not replaced: %+v", got)
+	}
+	if _, err := client.Index(locator).SearchByVector(context.Background(), model.SearchByVectorRequest{}, vector.WithRequestID("r")); err != wantErr {
+		t.Fatalf("err = %v, want the stubbed error", err)
+	}
+	calls := client.IndexFake(locator).CallsTo("SearchByVector")
+	if len(calls) != 1 || len(calls[0].Options) != 1 {
+		t.Fatalf("calls = %+v", calls)
+	}
+	if other := client.IndexFake(model.IndexLocator{IndexName: "other"}); len(other.Calls()) != 0 {
+		t.Fatal("fakes for different locators share calls")
+	}
+	if err := client.Close(); err != nil || !client.Closed() {
+		t.Fatalf("Close = %v, closed = %v", err, client.Closed())
+	}
+}
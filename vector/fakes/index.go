@@ -0,0 +1,178 @@
+// Copyright (c) 2025 Beijing Volcano Engine Technology Co., Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package fakes
+
+import (
+	"context"
+
+	"github.com/volcengine/vikingdb-go-sdk/vector"
+	"github.com/volcengine/vikingdb-go-sdk/vector/model"
+)
+
+// IndexClient is a configurable vector.IndexClient.
+type IndexClient struct {
+	Recorder
+	Locator model.IndexLocator
+
+	FetchFunc              func(ctx context.Context, request model.FetchDataInIndexRequest, opts ...vector.RequestOption) (*model.FetchDataInIndexResponse, error)
+	SearchByVectorFunc     func(ctx context.Context, request model.SearchByVectorRequest, opts ...vector.RequestOption) (*model.SearchResponse, error)
+	SearchByMultiModalFunc func(ctx context.Context, request model.SearchByMultiModalRequest, opts ...vector.RequestOption) (*model.SearchResponse, error)
+	SearchByIDFunc         func(ctx context.Context, request model.SearchByIDRequest, opts ...vector.RequestOption) (*model.SearchResponse, error)
+	SearchByScalarFunc     func(ctx context.Context, request model.SearchByScalarRequest, opts ...vector.RequestOption) (*model.SearchResponse, error)
+	SearchByKeywordsFunc   func(ctx context.Context, request model.SearchByKeywordsRequest, opts ...vector.RequestOption) (*model.SearchResponse, error)
+	SearchByRandomFunc     func(ctx context.Context, request model.SearchByRandomRequest, opts ...vector.RequestOption) (*model.SearchResponse, error)
+	AggregateFunc          func(ctx context.Context, request model.AggRequest, opts ...vector.RequestOption) (*model.AggResponse, error)
+}
+
+var _ vector.IndexClient = (*IndexClient)(nil)
+
+// Fetch records the call and delegates to FetchFunc.
+func (f *IndexClient) Fetch(ctx context.Context, request model.FetchDataInIndexRequest, opts ...vector.RequestOption) (*model.FetchDataInIndexResponse, error) {
+	f.record("Fetch", request, opts)
+	if f.FetchFunc != nil {
+		return f.FetchFunc(ctx, request, opts...)
+	}
+	return &model.FetchDataInIndexResponse{Result: &model.FetchDataInIndexResult{}}, nil
+}
+
+// SearchByVector records the call and delegates to SearchByVectorFunc.
+func (f *IndexClient) SearchByVector(ctx context.Context, request model.SearchByVectorRequest, opts ...vector.RequestOption) (*model.SearchResponse, error) {
+	f.record("SearchByVector", request, opts)
+	if f.SearchByVectorFunc != nil {
+		return f.SearchByVectorFunc(ctx, request, opts...)
+	}
+	return &model.SearchResponse{Result: &model.SearchResult{}}, nil
+}
+
+// SearchByMultiModal records the call and delegates to SearchByMultiModalFunc.
+func (f *IndexClient) SearchByMultiModal(ctx context.Context, request model.SearchByMultiModalRequest, opts ...vector.RequestOption) (*model.SearchResponse, error) {
+	f.record("SearchByMultiModal", request, opts)
+	if f.SearchByMultiModalFunc != nil {
+		return f.SearchByMultiModalFunc(ctx, request, opts...)
+	}
+	return &model.SearchResponse{Result: &model.SearchResult{}}, nil
+}
+
+// SearchByID records the call and delegates to SearchByIDFunc.
+func (f *IndexClient) SearchByID(ctx context.Context, request model.SearchByIDRequest, opts ...vector.RequestOption) (*model.SearchResponse, error) {
+	f.record("SearchByID", request, opts)
+	if f.SearchByIDFunc != nil {
+		return f.SearchByIDFunc(ctx, request, opts...)
+	}
+	return &model.SearchResponse{Result: &model.SearchResult{}}, nil
+}
+
+// SearchByScalar records the call and delegates to SearchByScalarFunc.
+func (f *IndexClient) SearchByScalar(ctx context.Context, request model.SearchByScalarRequest, opts ...vector.RequestOption) (*model.SearchResponse, error) {
+	f.record("SearchByScalar", request, opts)
+	if f.SearchByScalarFunc != nil {
+		return f.SearchByScalarFunc(ctx, request, opts...)
+	}
+	return &model.SearchResponse{Result: &model.SearchResult{}}, nil
+}
+
+// SearchByKeywords records the call and delegates to SearchByKeywordsFunc.
+func (f *IndexClient) SearchByKeywords(ctx context.Context, request model.SearchByKeywordsRequest, opts ...vector.RequestOption) (*model.SearchResponse, error) {
+	f.record("SearchByKeywords", request, opts)
+	if f.SearchByKeywordsFunc != nil {
+		return f.SearchByKeywordsFunc(ctx, request, opts...)
+	}
+	return &model.SearchResponse{Result: &model.SearchResult{}}, nil
+}
+
+// SearchByRandom records the call and delegates to SearchByRandomFunc.
+func (f *IndexClient) SearchByRandom(ctx context.Context, request model.SearchByRandomRequest, opts ...vector.RequestOption) (*model.SearchResponse, error) {
+	f.record("SearchByRandom", request, opts)
+	if f.SearchByRandomFunc != nil {
+		return f.SearchByRandomFunc(ctx, request, opts...)
+	}
+	return &model.SearchResponse{Result: &model.SearchResult{}}, nil
+}
+
+// Aggregate records the call and delegates to AggregateFunc.
+func (f *IndexClient) Aggregate(ctx context.Context, request model.AggRequest, opts ...vector.RequestOption) (*model.AggResponse, error) {
+	f.record("Aggregate", request, opts)
+	if f.AggregateFunc != nil {
+		return f.AggregateFunc(ctx, request, opts...)
+	}
+	return &model.AggResponse{Result: &model.AggResult{}}, nil
+}
+
+// CollectionName returns the locator's collection name.
+func (f *IndexClient) CollectionName() string {
+	return f.Locator.CollectionName
+}
+
+// IndexName returns the locator's index name.
+func (f *IndexClient) IndexName() string {
+	return f.Locator.IndexName
+}
+
+// ResourceID returns the locator's resource ID.
+func (f *IndexClient) ResourceID() string {
+	return f.Locator.ResourceID
+}
+
+// ProjectName returns the locator's project name.
+func (f *IndexClient) ProjectName() string {
+	return f.Locator.ProjectName
+}
+
+// IndexAdminClient is a configurable vector.IndexAdminClient.
+type IndexAdminClient struct {
+	Recorder
+
+	CreateIndexFunc func(ctx context.Context, request model.CreateIndexRequest, opts ...vector.RequestOption) (*model.CreateIndexResponse, error)
+	GetIndexFunc    func(ctx context.Context, request model.GetIndexRequest, opts ...vector.RequestOption) (*model.GetIndexResponse, error)
+	ListIndexesFunc func(ctx context.Context, request model.ListIndexesRequest, opts ...vector.RequestOption) (*model.ListIndexesResponse, error)
+	UpdateIndexFunc func(ctx context.Context, request model.UpdateIndexRequest, opts ...vector.RequestOption) (*model.UpdateIndexResponse, error)
+	DeleteIndexFunc func(ctx context.Context, request model.DeleteIndexRequest, opts ...vector.RequestOption) (*model.DeleteIndexResponse, error)
+}
+
+var _ vector.IndexAdminClient = (*IndexAdminClient)(nil)
+
+// CreateIndex records the call and delegates to CreateIndexFunc.
+func (f *IndexAdminClient) CreateIndex(ctx context.Context, request model.CreateIndexRequest, opts ...vector.RequestOption) (*model.CreateIndexResponse, error) {
+	f.record("CreateIndex", request, opts)
+	if f.CreateIndexFunc != nil {
+		return f.CreateIndexFunc(ctx, request, opts...)
+	}
+	return &model.CreateIndexResponse{Index: &model.Index{}}, nil
+}
+
+// GetIndex records the call and delegates to GetIndexFunc.
+func (f *IndexAdminClient) GetIndex(ctx context.Context, request model.GetIndexRequest, opts ...vector.RequestOption) (*model.GetIndexResponse, error) {
+	f.record("GetIndex", request, opts)
+	if f.GetIndexFunc != nil {
+		return f.GetIndexFunc(ctx, request, opts...)
+	}
+	return &model.GetIndexResponse{Index: &model.Index{}}, nil
+}
+
+// ListIndexes records the call and delegates to ListIndexesFunc.
+func (f *IndexAdminClient) ListIndexes(ctx context.Context, request model.ListIndexesRequest, opts ...vector.RequestOption) (*model.ListIndexesResponse, error) {
+	f.record("ListIndexes", request, opts)
+	if f.ListIndexesFunc != nil {
+		return f.ListIndexesFunc(ctx, request, opts...)
+	}
+	return &model.ListIndexesResponse{}, nil
+}
+
+// UpdateIndex records the call and delegates to UpdateIndexFunc.
+func (f *IndexAdminClient) UpdateIndex(ctx context.Context, request model.UpdateIndexRequest, opts ...vector.RequestOption) (*model.UpdateIndexResponse, error) {
+	f.record("UpdateIndex", request, opts)
+	if f.UpdateIndexFunc != nil {
+		return f.UpdateIndexFunc(ctx, request, opts...)
+	}
+	return &model.UpdateIndexResponse{Index: &model.Index{}}, nil
+}
+
+// DeleteIndex records the call and delegates to DeleteIndexFunc.
+func (f *IndexAdminClient) DeleteIndex(ctx context.Context, request model.DeleteIndexRequest, opts ...vector.RequestOption) (*model.DeleteIndexResponse, error) {
+	f.record("DeleteIndex", request, opts)
+	if f.DeleteIndexFunc != nil {
+		return f.DeleteIndexFunc(ctx, request, opts...)
+	}
+	return &model.DeleteIndexResponse{}, nil
+}
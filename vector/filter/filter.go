@@ -0,0 +1,428 @@
+// Copyright (c) 2025 Beijing Volcano Engine Technology Co., Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package filter builds the scalar filter expressions accepted by RecallBase.Filter and AggRequest.Cond.
+//
+// Expressions are validated while they are composed; Build reports the first problem found so that
+// malformed filters never reach the search endpoints:
+//
+//	f, err := filter.And(
+//		filter.Must("category", "news", "blog"),
+//		filter.Range("score", filter.Gte(85)),
+//	).Build()
+package filter
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/volcengine/vikingdb-go-sdk/vector/model"
+)
+
+// Operators understood by the VikingDB filter DSL.
+const (
+	OpAnd      = "and"
+	OpOr       = "or"
+	OpMust     = "must"
+	OpMustNot  = "must_not"
+	OpRange    = "range"
+	OpRangeOut = "range_out"
+	OpGeoRange = "geo_range"
+	OpPrefix   = "prefix"
+	OpContains = "contains"
+)
+
+// Filter is an immutable, validated filter expression. The zero value is an empty filter that matches
+// every document and builds to a nil MapStr.
+type Filter struct {
+	op     string
+	field  string
+	params model.MapStr
+	conds  []Filter
+	err    error
+}
+
+// Build validates the expression and converts it into the MapStr wire format.
+func (f Filter) Build() (model.MapStr, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	if f.op == "" {
+		return nil, nil
+	}
+	out := model.MapStr{"op": f.op}
+	if f.field != "" {
+		out["field"] = f.field
+	}
+	for k, v := range f.params {
+		out[k] = v
+	}
+	if len(f.conds) > 0 {
+		conds := make([]model.MapStr, 0, len(f.conds))
+		for _, cond := range f.conds {
+			built, err := cond.Build()
+			if err != nil {
+				return nil, err
+			}
+			conds = append(conds, built)
+		}
+		out["conds"] = conds
+	}
+	return out, nil
+}
+
+// MustBuild is like Build but panics when the expression is invalid.
+func (f Filter) MustBuild() model.MapStr {
+	out, err := f.Build()
+	if err != nil {
+		panic(err)
+	}
+	return out
+}
+
+// Err returns the validation error recorded while composing the expression.
+func (f Filter) Err() error {
+	return f.err
+}
+
+// IsEmpty reports whether the filter matches every document.
+func (f Filter) IsEmpty() bool {
+	return f.op == "" && f.err == nil
+}
+
+// MarshalJSON renders the filter in the exact JSON shape expected by the search endpoints.
+func (f Filter) MarshalJSON() ([]byte, error) {
+	out, err := f.Build()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(out)
+}
+
+func invalid(format string, args ...interface{}) Filter {
+	return Filter{err: model.NewInvalidParameterError("filter: " + fmt.Sprintf(format, args...))}
+}
+
+// And matches documents satisfying every condition. Empty conditions are skipped and a single
+// remaining condition is returned unchanged.
+func And(conds ...Filter) Filter {
+	return logical(OpAnd, conds)
+}
+
+// Or matches documents satisfying at least one condition. Empty conditions are skipped and a single
+// remaining condition is returned unchanged.
+func Or(conds ...Filter) Filter {
+	return logical(OpOr, conds)
+}
+
+func logical(op string, conds []Filter) Filter {
+	kept := make([]Filter, 0, len(conds))
+	for _, cond := range conds {
+		if cond.err != nil {
+			return cond
+		}
+		if cond.IsEmpty() {
+			continue
+		}
+		kept = append(kept, cond)
+	}
+	switch len(kept) {
+	case 0:
+		return Filter{}
+	case 1:
+		return kept[0]
+	}
+	return Filter{op: op, conds: kept}
+}
+
+// Not negates a condition. The service has no generic negation operator, so the expression is rewritten:
+// must and must_not swap, range and range_out swap, and and/or are pushed down with De Morgan's laws.
+// Negating geo, prefix or contains conditions is rejected.
+func Not(cond Filter) Filter {
+	if cond.err != nil {
+		return cond
+	}
+	switch cond.op {
+	case "":
+		return invalid("cannot negate an empty filter")
+	case OpMust, OpMustNot, OpRange, OpRangeOut:
+		negated := cond
+		negated.op = map[string]string{
+			OpMust:     OpMustNot,
+			OpMustNot:  OpMust,
+			OpRange:    OpRangeOut,
+			OpRangeOut: OpRange,
+		}[cond.op]
+		return negated
+	case OpAnd, OpOr:
+		negated := make([]Filter, 0, len(cond.conds))
+		for _, c := range cond.conds {
+			negated = append(negated, Not(c))
+		}
+		if cond.op == OpAnd {
+			return Or(negated...)
+		}
+		return And(negated...)
+	}
+	return invalid("operator %q cannot be negated", cond.op)
+}
+
+// Must matches documents whose field equals any of values.
+func Must(field string, values ...interface{}) Filter {
+	return membership(OpMust, field, values)
+}
+
+// MustNot matches documents whose field equals none of values.
+func MustNot(field string, values ...interface{}) Filter {
+	return membership(OpMustNot, field, values)
+}
+
+func membership(op, field string, values []interface{}) Filter {
+	if strings.TrimSpace(field) == "" {
+		return invalid("%s requires a field name", op)
+	}
+	if len(values) == 0 {
+		return invalid("%s on %q requires at least one value", op, field)
+	}
+	conds := make([]interface{}, 0, len(values))
+	for _, value := range values {
+		if !isScalar(value) {
+			return invalid("%s on %q: unsupported value %v (%T)", op, field, value, value)
+		}
+		conds = append(conds, value)
+	}
+	return Filter{op: op, field: field, params: model.MapStr{"conds": conds}}
+}
+
+// Bound restricts one side of a Range condition.
+type Bound struct {
+	key   string
+	value interface{}
+}
+
+// Gt requires the field to be strictly greater than value.
+func Gt(value interface{}) Bound { return Bound{key: "gt", value: value} }
+
+// Gte requires the field to be greater than or equal to value.
+func Gte(value interface{}) Bound { return Bound{key: "gte", value: value} }
+
+// Lt requires the field to be strictly less than value.
+func Lt(value interface{}) Bound { return Bound{key: "lt", value: value} }
+
+// Lte requires the field to be less than or equal to value.
+func Lte(value interface{}) Bound { return Bound{key: "lte", value: value} }
+
+// Range matches documents whose field lies within the bounds.
+func Range(field string, bounds ...Bound) Filter {
+	return rangeFilter(OpRange, field, bounds)
+}
+
+// RangeOut matches documents whose field lies outside the bounds.
+func RangeOut(field string, bounds ...Bound) Filter {
+	return rangeFilter(OpRangeOut, field, bounds)
+}
+
+func rangeFilter(op, field string, bounds []Bound) Filter {
+	if strings.TrimSpace(field) == "" {
+		return invalid("%s requires a field name", op)
+	}
+	if len(bounds) == 0 {
+		return invalid("%s on %q requires at least one bound", op, field)
+	}
+	params := model.MapStr{}
+	for _, bound := range bounds {
+		if bound.key == "" {
+			return invalid("%s on %q: zero Bound", op, field)
+		}
+		if _, ok := toFloat(bound.value); !ok {
+			if _, isString := bound.value.(string); !isString {
+				return invalid("%s on %q: bound %s must be a number or string, got %T", op, field, bound.key, bound.value)
+			}
+		}
+		if _, dup := params[bound.key]; dup {
+			return invalid("%s on %q: bound %s given twice", op, field, bound.key)
+		}
+		params[bound.key] = bound.value
+	}
+	_, gt := params["gt"]
+	_, gte := params["gte"]
+	_, lt := params["lt"]
+	_, lte := params["lte"]
+	if gt && gte {
+		return invalid("%s on %q: gt and gte are mutually exclusive", op, field)
+	}
+	if lt && lte {
+		return invalid("%s on %q: lt and lte are mutually exclusive", op, field)
+	}
+
+	lower, hasLower := firstFloat(params, "gt", "gte")
+	upper, hasUpper := firstFloat(params, "lt", "lte")
+	if hasLower && hasUpper && lower > upper {
+		return invalid("%s on %q: lower bound %v exceeds upper bound %v", op, field, lower, upper)
+	}
+	return Filter{op: op, field: field, params: params}
+}
+
+// GeoRange matches documents whose geo_point field lies within radius meters of (longitude, latitude).
+func GeoRange(field string, longitude, latitude, radius float64) Filter {
+	if strings.TrimSpace(field) == "" {
+		return invalid("%s requires a field name", OpGeoRange)
+	}
+	if longitude < -180 || longitude > 180 {
+		return invalid("%s on %q: longitude %v out of range", OpGeoRange, field, longitude)
+	}
+	if latitude < -90 || latitude > 90 {
+		return invalid("%s on %q: latitude %v out of range", OpGeoRange, field, latitude)
+	}
+	if radius <= 0 {
+		return invalid("%s on %q: radius must be positive", OpGeoRange, field)
+	}
+	return Filter{op: OpGeoRange, field: field, params: model.MapStr{
+		"center": []float64{longitude, latitude},
+		"radius": radius,
+	}}
+}
+
+// Prefix matches documents whose string field starts with prefix.
+func Prefix(field, prefix string) Filter {
+	return substring(OpPrefix, field, prefix)
+}
+
+// Contains matches documents whose string field contains substr.
+func Contains(field, substr string) Filter {
+	return substring(OpContains, field, substr)
+}
+
+func substring(op, field, value string) Filter {
+	if strings.TrimSpace(field) == "" {
+		return invalid("%s requires a field name", op)
+	}
+	if value == "" {
+		return invalid("%s on %q requires a non-empty value", op, field)
+	}
+	return Filter{op: op, field: field, params: model.MapStr{op: value}}
+}
+
+func isScalar(value interface{}) bool {
+	switch value.(type) {
+	case string, bool, json.Number,
+		int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64, float32, float64:
+		return true
+	}
+	return false
+}
+
+func firstFloat(params model.MapStr, keys ...string) (float64, bool) {
+	for _, key := range keys {
+		if v, ok := params[key]; ok {
+			return toFloat(v)
+		}
+	}
+	return 0, false
+}
+
+func toFloat(value interface{}) (float64, bool) {
+	switch n := value.(type) {
+	case int:
+		return float64(n), true
+	case int8:
+		return float64(n), true
+	case int16:
+		return float64(n), true
+	case int32:
+		return float64(n), true
+	case int64:
+		return float64(n), true
+	case uint:
+		return float64(n), true
+	case uint8:
+		return float64(n), true
+	case uint16:
+		return float64(n), true
+	case uint32:
+		return float64(n), true
+	case uint64:
+		return float64(n), true
+	case float32:
+		return float64(n), true
+	case float64:
+		return n, true
+	case json.Number:
+		f, err := n.Float64()
+		return f, err == nil
+	}
+	return 0, false
+}
+
+// Raw wraps a hand-written filter map so it can be combined with typed conditions. The map must carry
+// an "op" key; it is otherwise passed through unchanged. The conds of an and/or map are wrapped in turn,
+// so a raw logical filter can be negated like a typed one.
+func Raw(expr model.MapStr) Filter {
+	if len(expr) == 0 {
+		return Filter{}
+	}
+	op, _ := expr["op"].(string)
+	if op == "" {
+		return invalid("raw filter requires an op")
+	}
+	params := model.MapStr{}
+	field := ""
+	var conds []Filter
+	for k, v := range expr {
+		switch {
+		case k == "op":
+		case k == "field":
+			field, _ = v.(string)
+		case k == "conds" && (op == OpAnd || op == OpOr):
+			parsed, err := rawConds(op, v)
+			if err != nil {
+				return Filter{err: err}
+			}
+			conds = parsed
+		default:
+			params[k] = v
+		}
+	}
+	if (op == OpAnd || op == OpOr) && len(conds) == 0 {
+		return invalid("raw %s filter requires conds", op)
+	}
+	return Filter{op: op, field: field, params: params, conds: conds}
+}
+
+func rawConds(op string, value interface{}) ([]Filter, error) {
+	var maps []model.MapStr
+	switch v := value.(type) {
+	case []model.MapStr:
+		maps = v
+	case []map[string]interface{}:
+		for _, m := range v {
+			maps = append(maps, m)
+		}
+	case []interface{}:
+		for _, item := range v {
+			switch m := item.(type) {
+			case model.MapStr:
+				maps = append(maps, m)
+			case map[string]interface{}:
+				maps = append(maps, m)
+			default:
+				return nil, invalid("raw %s filter: cond %v is not a map", op, item).err
+			}
+		}
+	default:
+		return nil, invalid("raw %s filter: conds must be a list of maps, got %T", op, value).err
+	}
+	conds := make([]Filter, 0, len(maps))
+	for _, m := range maps {
+		cond := Raw(m)
+		if cond.err != nil {
+			return nil, cond.err
+		}
+		if cond.IsEmpty() {
+			return nil, invalid("raw %s filter: empty cond", op).err
+		}
+		conds = append(conds, cond)
+	}
+	return conds, nil
+}
@@ -0,0 +1,138 @@
+// Copyright (c) 2025 Beijing Volcano Engine Technology Co., Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package filter_test
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/volcengine/vikingdb-go-sdk/vector/filter"
+	"github.com/volcengine/vikingdb-go-sdk/vector/model"
+)
+
+func TestBuild(t *testing.T) {
+	cases := []struct {
+		name   string
+		filter filter.Filter
+		want   string
+	}{
+		{name: "empty", filter: filter.Filter{}, want: `null`},
+		{name: "must", filter: filter.Must("color", "red", "blue"), want: `{"conds":["red","blue"],"field":"color","op":"must"}`},
+		{name: "must not", filter: filter.MustNot("id", 1, int64(2)), want: `{"conds":[1,2],"field":"id","op":"must_not"}`},
+		{name: "range", filter: filter.Range("price", filter.Gte(10), filter.Lt(20.5)), want: `{"field":"price","gte":10,"lt":20.5,"op":"range"}`},
+		{name: "range out", filter: filter.RangeOut("date", filter.Gt("2024-01-01")), want: `{"field":"date","gt":"2024-01-01","op":"range_out"}`},
+		{name: "geo range", filter: filter.GeoRange("loc", 116.4, 39.9, 500), want: `{"center":[116.4,39.9],"field":"loc","op":"geo_range","radius":500}`},
+		{name: "prefix", filter: filter.Prefix("title", "app"), want: `{"field":"title","op":"prefix","prefix":"app"}`},
+		{name: "contains", filter: filter.Contains("title", "pie"), want: `{"contains":"pie","field":"title","op":"contains"}`},
+		{
+			name:   "and",
+			filter: filter.And(filter.Must("a", 1), filter.Range("b", filter.Lte(2))),
+			want:   `{"conds":[{"conds":[1],"field":"a","op":"must"},{"field":"b","lte":2,"op":"range"}],"op":"and"}`,
+		},
+		{
+			name:   "or",
+			filter: filter.Or(filter.Prefix("a", "x"), filter.Contains("b", "y")),
+			want:   `{"conds":[{"field":"a","op":"prefix","prefix":"x"},{"contains":"y","field":"b","op":"contains"}],"op":"or"}`,
+		},
+		{
+			name:   "nested",
+			filter: filter.And(filter.Must("a", 1), filter.Or(filter.Must("b", 2), filter.MustNot("c", 3))),
+			want:   `{"conds":[{"conds":[1],"field":"a","op":"must"},{"conds":[{"conds":[2],"field":"b","op":"must"},{"conds":[3],"field":"c","op":"must_not"}],"op":"or"}],"op":"and"}`,
+		},
+		{name: "empty conditions are skipped", filter: filter.And(filter.Filter{}, filter.Must("a", 1), filter.Or()), want: `{"conds":[1],"field":"a","op":"must"}`},
+		{name: "raw", filter: filter.Raw(model.MapStr{"op": "must", "field": "a", "conds": []interface{}{1}}), want: `{"conds":[1],"field":"a","op":"must"}`},
+		{
+			name:   "raw and",
+			filter: filter.Raw(model.MapStr{"op": "and", "conds": []interface{}{map[string]interface{}{"op": "must", "field": "a", "conds": []interface{}{1}}}}),
+			want:   `{"conds":[{"conds":[1],"field":"a","op":"must"}],"op":"and"}`,
+		},
+		{name: "not must", filter: filter.Not(filter.Must("a", 1)), want: `{"conds":[1],"field":"a","op":"must_not"}`},
+		{name: "not must not", filter: filter.Not(filter.MustNot("a", 1)), want: `{"conds":[1],"field":"a","op":"must"}`},
+		{name: "not range", filter: filter.Not(filter.Range("a", filter.Gt(1))), want: `{"field":"a","gt":1,"op":"range_out"}`},
+		{name: "not range out", filter: filter.Not(filter.RangeOut("a", filter.Gt(1))), want: `{"field":"a","gt":1,"op":"range"}`},
+		{
+			name:   "not and",
+			filter: filter.Not(filter.And(filter.Must("a", 1), filter.Range("b", filter.Lt(2)))),
+			want:   `{"conds":[{"conds":[1],"field":"a","op":"must_not"},{"field":"b","lt":2,"op":"range_out"}],"op":"or"}`,
+		},
+		{
+			name:   "not or",
+			filter: filter.Not(filter.Or(filter.Must("a", 1), filter.MustNot("b", 2))),
+			want:   `{"conds":[{"conds":[1],"field":"a","op":"must_not"},{"conds":[2],"field":"b","op":"must"}],"op":"and"}`,
+		},
+		{
+			name: "not raw and",
+			filter: filter.Not(filter.Raw(model.MapStr{"op": "and", "conds": []model.MapStr{
+				{"op": "must", "field": "a", "conds": []interface{}{1}},
+				{"op": "range", "field": "b", "gte": 2},
+			}})),
+			want: `{"conds":[{"conds":[1],"field":"a","op":"must_not"},{"field":"b","gte":2,"op":"range_out"}],"op":"or"}`,
+		},
+		{name: "double negation", filter: filter.Not(filter.Not(filter.Must("a", 1))), want: `{"conds":[1],"field":"a","op":"must"}`},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			built, err := tc.filter.Build()
+			if err != nil {
+				t.Fatal(err)
+			}
+			got, err := json.Marshal(built)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(got) != tc.want {
+				t.Fatalf("Build() = %s, want %s", got, tc.want)
+			}
+			marshalled, err := json.Marshal(tc.filter)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(marshalled) != tc.want {
+				t.Fatalf("MarshalJSON = %s, want %s", marshalled, tc.want)
+			}
+		})
+	}
+}
+
+func TestBuildRejectsInvalidFilters(t *testing.T) {
+	cases := []struct {
+		name   string
+		filter filter.Filter
+	}{
+		{name: "must without field", filter: filter.Must("", 1)},
+		{name: "must without values", filter: filter.Must("a")},
+		{name: "must with a non-scalar value", filter: filter.Must("a", []int{1})},
+		{name: "range without bounds", filter: filter.Range("a")},
+		{name: "range with gt and gte", filter: filter.Range("a", filter.Gt(1), filter.Gte(1))},
+		{name: "range with crossed bounds", filter: filter.Range("a", filter.Gt(5), filter.Lt(1))},
+		{name: "range with a non-numeric bound", filter: filter.Range("a", filter.Gt(true))},
+		{name: "geo range with a bad latitude", filter: filter.GeoRange("loc", 0, 91, 1)},
+		{name: "geo range without radius", filter: filter.GeoRange("loc", 0, 0, 0)},
+		{name: "empty prefix", filter: filter.Prefix("a", "")},
+		{name: "invalid condition inside and", filter: filter.And(filter.Must("a", 1), filter.Contains("", "x"))},
+		{name: "raw without op", filter: filter.Raw(model.MapStr{"field": "a"})},
+		{name: "raw and without conds", filter: filter.Raw(model.MapStr{"op": "or"})},
+		{name: "raw and with a non-map cond", filter: filter.Raw(model.MapStr{"op": "and", "conds": []interface{}{"x"}})},
+		{name: "not empty", filter: filter.Not(filter.Filter{})},
+		{name: "not geo range", filter: filter.Not(filter.GeoRange("loc", 0, 0, 1))},
+		{name: "not prefix inside or", filter: filter.Not(filter.Or(filter.Must("a", 1), filter.Prefix("b", "x")))},
+		{name: "not raw or with a prefix", filter: filter.Not(filter.Raw(model.MapStr{"op": "or", "conds": []interface{}{
+			model.MapStr{"op": "must", "field": "a", "conds": []interface{}{1}},
+			model.MapStr{"op": "prefix", "field": "b", "prefix": "x"},
+		}}))},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := tc.filter.Build()
+			var sdkErr *model.Error
+			if !errors.As(err, &sdkErr) || sdkErr.Code != model.ErrCodeInvalidParameter {
+				t.Fatalf("err = %v, want an InvalidParameter error", err)
+			}
+			if tc.filter.Err() == nil {
+				t.Fatal("Err() = nil for an invalid filter")
+			}
+		})
+	}
+}
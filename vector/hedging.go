@@ -0,0 +1,173 @@
+// Copyright (c) 2025 Beijing Volcano Engine Technology Co., Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package vector
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/volcengine/vikingdb-go-sdk/vector/model"
+)
+
+const (
+	latencySampleSize       = 256
+	minLatencySamples       = 20
+	defaultHedgeDelay       = 100 * time.Millisecond
+	hedgeOperationsCapacity = 8
+)
+
+// HedgePolicy configures hedged search requests.
+type HedgePolicy struct {
+	// Delay is how long to wait for the first reply before sending the hedge. It is also the fallback
+	// while too few latency samples exist for Percentile (default 100ms).
+	Delay time.Duration
+	// Percentile, when in (0, 1), derives the delay from the latency observed for the operation at that
+	// percentile, e.g. 0.95.
+	Percentile float64
+}
+
+// WithRequestHedging sends a second identical request when the first has not answered within the policy's
+// delay and returns whichever reply arrives first, cancelling the other. It only applies to the idempotent
+// SearchByVector, SearchByMultiModal and SearchByKeywords calls and is ignored elsewhere.
+func WithRequestHedging(policy HedgePolicy) RequestOption {
+	return func(o *RequestOptions) {
+		o.Hedge = &policy
+	}
+}
+
+// latencyTracker keeps a ring of recent latencies per operation.
+type latencyTracker struct {
+	mu      sync.Mutex
+	samples map[string]*latencyRing
+}
+
+type latencyRing struct {
+	values []time.Duration
+	next   int
+}
+
+func newLatencyTracker() *latencyTracker {
+	return &latencyTracker{samples: make(map[string]*latencyRing, hedgeOperationsCapacity)}
+}
+
+func (l *latencyTracker) observe(operation string, d time.Duration) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	ring, ok := l.samples[operation]
+	if !ok {
+		ring = &latencyRing{}
+		l.samples[operation] = ring
+	}
+	if len(ring.values) < latencySampleSize {
+		ring.values = append(ring.values, d)
+		return
+	}
+	ring.values[ring.next] = d
+	ring.next = (ring.next + 1) % latencySampleSize
+}
+
+func (l *latencyTracker) percentile(operation string, p float64) (time.Duration, bool) {
+	l.mu.Lock()
+	ring, ok := l.samples[operation]
+	if !ok || len(ring.values) < minLatencySamples {
+		l.mu.Unlock()
+		return 0, false
+	}
+	values := append([]time.Duration(nil), ring.values...)
+	l.mu.Unlock()
+
+	sort.Slice(values, func(i, j int) bool { return values[i] < values[j] })
+	idx := int(p * float64(len(values)))
+	if idx >= len(values) {
+		idx = len(values) - 1
+	}
+	return values[idx], true
+}
+
+func (c *transport) hedgeDelay(operation string, policy HedgePolicy) time.Duration {
+	if policy.Percentile > 0 && policy.Percentile < 1 {
+		if d, ok := c.latencies.percentile(operation, policy.Percentile); ok {
+			return d
+		}
+	}
+	if policy.Delay > 0 {
+		return policy.Delay
+	}
+	return defaultHedgeDelay
+}
+
+type hedgeResult struct {
+	response *model.SearchResponse
+	err      error
+}
+
+// doSearchRequest performs an idempotent search call, hedging it when WithRequestHedging is set.
+func (c *transport) doSearchRequest(ctx context.Context, method, path string, request interface{}, opts ...RequestOption) (*model.SearchResponse, error) {
+	if c == nil {
+		return nil, errClientNotInitialized()
+	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	requestOpts := defaultRequestOptions()
+	for _, opt := range opts {
+		opt(requestOpts)
+	}
+	operation := operationName(path)
+
+	send := func(ctx context.Context) (*model.SearchResponse, error) {
+		start := time.Now()
+		response := &model.SearchResponse{}
+		err := c.doRequest(ctx, method, path, request, response, opts...)
+		if err == nil {
+			c.latencies.observe(operation, time.Since(start))
+		}
+		return response, err
+	}
+	if requestOpts.Hedge == nil {
+		return send(ctx)
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	results := make(chan hedgeResult, 2)
+	launch := func() {
+		go func() {
+			response, err := send(ctx)
+			results <- hedgeResult{response: response, err: err}
+		}()
+	}
+
+	launch()
+	timer := time.NewTimer(c.hedgeDelay(operation, *requestOpts.Hedge))
+	defer timer.Stop()
+
+	inFlight, hedged := 1, false
+	var firstErr hedgeResult
+	for {
+		select {
+		case <-timer.C:
+			if !hedged {
+				hedged = true
+				inFlight++
+				launch()
+			}
+		case result := <-results:
+			inFlight--
+			if result.err == nil {
+				return result.response, nil
+			}
+			if firstErr.err == nil {
+				firstErr = result
+			}
+			if inFlight == 0 {
+				// Once the only request failed, hedging can no longer help.
+				return firstErr.response, firstErr.err
+			}
+		}
+	}
+}
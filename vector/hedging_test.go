@@ -0,0 +1,129 @@
+// Copyright (c) 2025 Beijing Volcano Engine Technology Co., Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package vector
+
+import (
+	"context"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/volcengine/vikingdb-go-sdk/vector/model"
+)
+
+const searchReply = `{"code":"Success","request_id":"r","result":{"data":[{"id":1}]}}`
+
+func TestHedgedSearch(t *testing.T) {
+	cases := []struct {
+		name string
+		// firstDelay is how long the first request takes; zero means it blocks until cancelled.
+		firstDelay     time.Duration
+		firstFails     bool
+		hedge          bool
+		wantRequests   int32
+		wantErr        bool
+		wantCancelled  bool
+		maxCallLatency time.Duration
+	}{
+		{name: "hedge wins and the slow request is cancelled", hedge: true, wantRequests: 2, wantCancelled: true, maxCallLatency: 2 * time.Second},
+		{name: "fast reply needs no hedge", firstDelay: time.Millisecond, hedge: true, wantRequests: 1},
+		{name: "lone failure returns without waiting for the delay", firstDelay: time.Millisecond, firstFails: true, hedge: true, wantRequests: 1, wantErr: true},
+		{name: "hedging disabled", firstDelay: 50 * time.Millisecond, wantRequests: 1},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			var requests int32
+			cancelled := make(chan struct{}, 1)
+			release := make(chan struct{})
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				// The server only notices a client disconnect once the body has been consumed.
+				_, _ = io.Copy(ioutil.Discard, r.Body)
+				if atomic.AddInt32(&requests, 1) == 1 {
+					if tc.firstDelay == 0 {
+						select {
+						case <-r.Context().Done():
+							cancelled <- struct{}{}
+						case <-release:
+						}
+						return
+					}
+					time.Sleep(tc.firstDelay)
+					if tc.firstFails {
+						w.WriteHeader(http.StatusBadRequest)
+						_, _ = w.Write([]byte(`{"code":"InvalidParameter","message":"bad"}`))
+						return
+					}
+				}
+				_, _ = w.Write([]byte(searchReply))
+			}))
+			defer srv.Close()
+			defer close(release)
+
+			client, err := New(AuthAPIKey("k"), WithEndpoint(srv.URL), WithMaxRetries(0))
+			if err != nil {
+				t.Fatal(err)
+			}
+			var opts []RequestOption
+			if tc.hedge {
+				opts = append(opts, WithRequestHedging(HedgePolicy{Delay: 20 * time.Millisecond}))
+			}
+			index := client.Index(model.IndexLocator{CollectionLocator: model.CollectionLocator{CollectionName: "c"}, IndexName: "i"})
+
+			start := time.Now()
+			resp, err := index.SearchByVector(context.Background(), model.SearchByVectorRequest{DenseVector: []float64{1}}, opts...)
+			if tc.wantErr != (err != nil) {
+				t.Fatalf("err = %v, want error %v", err, tc.wantErr)
+			}
+			if err == nil && (resp.Result == nil || len(resp.Result.Data) != 1) {
+				t.Fatalf("unexpected response %+v", resp)
+			}
+			if tc.maxCallLatency > 0 && time.Since(start) > tc.maxCallLatency {
+				t.Fatalf("call took %v", time.Since(start))
+			}
+			if tc.wantCancelled {
+				select {
+				case <-cancelled:
+				case <-time.After(2 * time.Second):
+					t.Fatal("the losing request was not cancelled")
+				}
+			}
+			time.Sleep(30 * time.Millisecond)
+			if got := atomic.LoadInt32(&requests); got != tc.wantRequests {
+				t.Fatalf("server saw %d requests, want %d", got, tc.wantRequests)
+			}
+		})
+	}
+}
+
+func TestLatencyPercentile(t *testing.T) {
+	tracker := newLatencyTracker()
+	if _, ok := tracker.percentile("op", 0.9); ok {
+		t.Fatal("percentile reported without samples")
+	}
+	for i := 1; i <= 100; i++ {
+		tracker.observe("op", time.Duration(i)*time.Millisecond)
+	}
+	if got, ok := tracker.percentile("op", 0.9); !ok || got != 91*time.Millisecond {
+		t.Fatalf("p90 = %v, %v", got, ok)
+	}
+	// The ring keeps only the latest samples.
+	for i := 0; i < latencySampleSize; i++ {
+		tracker.observe("op", time.Second)
+	}
+	if got, _ := tracker.percentile("op", 0.1); got != time.Second {
+		t.Fatalf("p10 = %v, want old samples evicted", got)
+	}
+
+	c := &transport{latencies: tracker}
+	if got := c.hedgeDelay("other", HedgePolicy{Percentile: 0.9, Delay: 5 * time.Millisecond}); got != 5*time.Millisecond {
+		t.Fatalf("fallback delay = %v", got)
+	}
+	if got := c.hedgeDelay("op", HedgePolicy{Percentile: 0.9}); got != time.Second {
+		t.Fatalf("percentile delay = %v", got)
+	}
+}
@@ -0,0 +1,45 @@
+// Copyright (c) 2025 Beijing Volcano Engine Technology Co., Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package vector
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/volcengine/vikingdb-go-sdk/vector/model"
+)
+
+type indexAdminClient struct {
+	client *transport
+}
+
+func (i *indexAdminClient) CreateIndex(ctx context.Context, request model.CreateIndexRequest, opts ...RequestOption) (*model.CreateIndexResponse, error) {
+	response := &model.CreateIndexResponse{}
+	err := i.client.doRequest(ctx, http.MethodPost, "/api/vikingdb/index/create", request, response, opts...)
+	return response, err
+}
+
+func (i *indexAdminClient) GetIndex(ctx context.Context, request model.GetIndexRequest, opts ...RequestOption) (*model.GetIndexResponse, error) {
+	response := &model.GetIndexResponse{}
+	err := i.client.doRequest(ctx, http.MethodPost, "/api/vikingdb/index/get", request, response, opts...)
+	return response, err
+}
+
+func (i *indexAdminClient) ListIndexes(ctx context.Context, request model.ListIndexesRequest, opts ...RequestOption) (*model.ListIndexesResponse, error) {
+	response := &model.ListIndexesResponse{}
+	err := i.client.doRequest(ctx, http.MethodPost, "/api/vikingdb/index/list", request, response, opts...)
+	return response, err
+}
+
+func (i *indexAdminClient) UpdateIndex(ctx context.Context, request model.UpdateIndexRequest, opts ...RequestOption) (*model.UpdateIndexResponse, error) {
+	response := &model.UpdateIndexResponse{}
+	err := i.client.doRequest(ctx, http.MethodPost, "/api/vikingdb/index/update", request, response, opts...)
+	return response, err
+}
+
+func (i *indexAdminClient) DeleteIndex(ctx context.Context, request model.DeleteIndexRequest, opts ...RequestOption) (*model.DeleteIndexResponse, error) {
+	response := &model.DeleteIndexResponse{}
+	err := i.client.doRequest(ctx, http.MethodPost, "/api/vikingdb/index/delete", request, response, opts...)
+	return response, err
+}
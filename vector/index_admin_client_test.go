@@ -0,0 +1,145 @@
+// Copyright (c) 2025 Beijing Volcano Engine Technology Co., Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package vector
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/volcengine/vikingdb-go-sdk/vector/model"
+)
+
+func TestIndexAdminRoundTrip(t *testing.T) {
+	collection := "docs"
+	indexJSON := `{"id":"idx-1","name":"idx","index_type":"hnsw","collection_id":"c-1","collection_name":"docs","index_params":{"m":16}}`
+	wantIndex := &model.Index{ID: "idx-1", Name: "idx", IndexType: "hnsw", CollectionID: "c-1", CollectionName: "docs", IndexParams: map[string]interface{}{"m": json.Number("16")}}
+
+	cases := []struct {
+		name     string
+		path     string
+		wantBody map[string]interface{}
+		reply    string
+		call     func(admin IndexAdminClient) (interface{}, error)
+		want     interface{}
+	}{
+		{
+			name:     "create",
+			path:     "/api/vikingdb/index/create",
+			wantBody: map[string]interface{}{"collection_name": "docs", "name": "idx", "index_type": "hnsw", "index_params": map[string]interface{}{"m": float64(16)}},
+			reply:    `{"code":"Success","request_id":"r1","index":` + indexJSON + `}`,
+			call: func(admin IndexAdminClient) (interface{}, error) {
+				resp, err := admin.CreateIndex(context.Background(), model.CreateIndexRequest{CollectionName: &collection, Name: "idx", IndexType: "hnsw", IndexParams: map[string]interface{}{"m": 16}})
+				return resp, err
+			},
+			want: &model.CreateIndexResponse{CommonResponse: model.CommonResponse{Code: "Success", RequestID: "r1"}, Index: wantIndex},
+		},
+		{
+			name:     "get",
+			path:     "/api/vikingdb/index/get",
+			wantBody: map[string]interface{}{"collection_name": "docs", "name": "idx"},
+			reply:    `{"code":"Success","request_id":"r2","index":` + indexJSON + `}`,
+			call: func(admin IndexAdminClient) (interface{}, error) {
+				resp, err := admin.GetIndex(context.Background(), model.GetIndexRequest{CollectionName: &collection, Name: "idx"})
+				return resp, err
+			},
+			want: &model.GetIndexResponse{CommonResponse: model.CommonResponse{Code: "Success", RequestID: "r2"}, Index: wantIndex},
+		},
+		{
+			name:     "list",
+			path:     "/api/vikingdb/index/list",
+			wantBody: map[string]interface{}{"collection_name": "docs", "page": float64(2), "page_size": float64(10), "name_prefix": "id"},
+			reply:    `{"code":"Success","request_id":"r3","total":11,"page":2,"page_size":10,"indexes":[` + indexJSON + `]}`,
+			call: func(admin IndexAdminClient) (interface{}, error) {
+				resp, err := admin.ListIndexes(context.Background(), model.ListIndexesRequest{CollectionName: &collection, PaginationRequest: model.PaginationRequest{Page: 2, PageSize: 10}, NamePrefix: "id"})
+				return resp, err
+			},
+			want: &model.ListIndexesResponse{
+				CommonResponse:     model.CommonResponse{Code: "Success", RequestID: "r3"},
+				PaginationResponse: model.PaginationResponse{Total: 11, Page: 2, PageSize: 10},
+				Indexes:            []*model.Index{wantIndex},
+			},
+		},
+		{
+			name:     "update",
+			path:     "/api/vikingdb/index/update",
+			wantBody: map[string]interface{}{"collection_name": "docs", "name": "idx", "description": "tuned"},
+			reply:    `{"code":"Success","request_id":"r4","index":` + indexJSON + `}`,
+			call: func(admin IndexAdminClient) (interface{}, error) {
+				resp, err := admin.UpdateIndex(context.Background(), model.UpdateIndexRequest{CollectionName: &collection, Name: "idx", Description: "tuned"})
+				return resp, err
+			},
+			want: &model.UpdateIndexResponse{CommonResponse: model.CommonResponse{Code: "Success", RequestID: "r4"}, Index: wantIndex},
+		},
+		{
+			name:     "delete",
+			path:     "/api/vikingdb/index/delete",
+			wantBody: map[string]interface{}{"collection_name": "docs", "name": "idx"},
+			reply:    `{"code":"Success","request_id":"r5"}`,
+			call: func(admin IndexAdminClient) (interface{}, error) {
+				resp, err := admin.DeleteIndex(context.Background(), model.DeleteIndexRequest{CollectionName: &collection, Name: "idx"})
+				return resp, err
+			},
+			want: &model.DeleteIndexResponse{CommonResponse: model.CommonResponse{Code: "Success", RequestID: "r5"}},
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				raw, _ := ioutil.ReadAll(r.Body)
+				var body map[string]interface{}
+				if err := json.Unmarshal(raw, &body); err != nil {
+					t.Errorf("request body %s: %v", raw, err)
+				}
+				if r.Method != http.MethodPost || r.URL.Path != tc.path {
+					t.Errorf("request = %s %s, want POST %s", r.Method, r.URL.Path, tc.path)
+				}
+				if !reflect.DeepEqual(body, tc.wantBody) {
+					t.Errorf("request body = %v, want %v", body, tc.wantBody)
+				}
+				_, _ = w.Write([]byte(tc.reply))
+			}))
+			defer srv.Close()
+			client, err := New(AuthAPIKey("key"), WithEndpoint(srv.URL), WithMaxRetries(0))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			got, err := tc.call(client.IndexAdmin())
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(got, tc.want) {
+				gotJSON, _ := json.Marshal(got)
+				wantJSON, _ := json.Marshal(tc.want)
+				t.Fatalf("response = %s, want %s", gotJSON, wantJSON)
+			}
+		})
+	}
+}
+
+func TestIndexAdminServerError(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = ioutil.ReadAll(r.Body)
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"code":"NotFound","message":"index idx not found","request_id":"r"}`))
+	}))
+	defer srv.Close()
+	client, err := New(AuthAPIKey("key"), WithEndpoint(srv.URL), WithMaxRetries(0))
+	if err != nil {
+		t.Fatal(err)
+	}
+	collection := "docs"
+
+	_, err = client.IndexAdmin().GetIndex(context.Background(), model.GetIndexRequest{CollectionName: &collection, Name: "idx"})
+	var sdkErr *model.Error
+	if !errors.As(err, &sdkErr) || sdkErr.Code != model.ErrCodeNotFound || sdkErr.StatusCode != http.StatusNotFound || sdkErr.RequestID != "r" {
+		t.Fatalf("err = %#v, want a NotFound error carrying the request id", err)
+	}
+}
@@ -5,6 +5,7 @@ package vector
 
 import (
 	"context"
+	"fmt"
 	"net/http"
 
 	"github.com/volcengine/vikingdb-go-sdk/vector/model"
@@ -29,7 +30,6 @@ func (i *indexClient) Fetch(ctx context.Context, request model.FetchDataInIndexR
 }
 
 func (i *indexClient) SearchByVector(ctx context.Context, request model.SearchByVectorRequest, opts ...RequestOption) (*model.SearchResponse, error) {
-	response := &model.SearchResponse{}
 	req := struct {
 		model.IndexLocator
 		model.SearchByVectorRequest
@@ -37,12 +37,10 @@ func (i *indexClient) SearchByVector(ctx context.Context, request model.SearchBy
 		IndexLocator:          i.indexBase,
 		SearchByVectorRequest: request,
 	}
-	err := i.transport.doRequest(ctx, http.MethodPost, "/api/vikingdb/data/search/vector", req, response, opts...)
-	return response, err
+	return i.transport.doSearchRequest(ctx, http.MethodPost, "/api/vikingdb/data/search/vector", req, opts...)
 }
 
 func (i *indexClient) SearchByMultiModal(ctx context.Context, request model.SearchByMultiModalRequest, opts ...RequestOption) (*model.SearchResponse, error) {
-	response := &model.SearchResponse{}
 	req := struct {
 		model.IndexLocator
 		model.SearchByMultiModalRequest
@@ -50,8 +48,7 @@ func (i *indexClient) SearchByMultiModal(ctx context.Context, request model.Sear
 		IndexLocator:              i.indexBase,
 		SearchByMultiModalRequest: request,
 	}
-	err := i.transport.doRequest(ctx, http.MethodPost, "/api/vikingdb/data/search/multi_modal", req, response, opts...)
-	return response, err
+	return i.transport.doSearchRequest(ctx, http.MethodPost, "/api/vikingdb/data/search/multi_modal", req, opts...)
 }
 
 func (i *indexClient) SearchByID(ctx context.Context, request model.SearchByIDRequest, opts ...RequestOption) (*model.SearchResponse, error) {
@@ -81,7 +78,6 @@ func (i *indexClient) SearchByScalar(ctx context.Context, request model.SearchBy
 }
 
 func (i *indexClient) SearchByKeywords(ctx context.Context, request model.SearchByKeywordsRequest, opts ...RequestOption) (*model.SearchResponse, error) {
-	response := &model.SearchResponse{}
 	req := struct {
 		model.IndexLocator
 		model.SearchByKeywordsRequest
@@ -89,8 +85,7 @@ func (i *indexClient) SearchByKeywords(ctx context.Context, request model.Search
 		IndexLocator:            i.indexBase,
 		SearchByKeywordsRequest: request,
 	}
-	err := i.transport.doRequest(ctx, http.MethodPost, "/api/vikingdb/data/search/keywords", req, response, opts...)
-	return response, err
+	return i.transport.doSearchRequest(ctx, http.MethodPost, "/api/vikingdb/data/search/keywords", req, opts...)
 }
 
 func (i *indexClient) SearchByRandom(ctx context.Context, request model.SearchByRandomRequest, opts ...RequestOption) (*model.SearchResponse, error) {
@@ -134,3 +129,20 @@ func (i *indexClient) ResourceID() string {
 func (i *indexClient) ProjectName() string {
 	return i.indexBase.ProjectName
 }
+
+// primaryKey describes the collection and returns the name of its primary key field.
+func (i *indexClient) primaryKey(ctx context.Context, opts ...RequestOption) (string, error) {
+	response := &model.DescribeCollectionResponse{}
+	request := model.DescribeCollectionRequest{CollectionLocator: i.indexBase.CollectionLocator}
+	if err := i.transport.doRequest(ctx, http.MethodPost, "/api/vikingdb/collection/get", request, response, opts...); err != nil {
+		return "", err
+	}
+	if response.Collection != nil {
+		for _, field := range response.Collection.Fields {
+			if field.IsPrimaryKey {
+				return field.FieldName, nil
+			}
+		}
+	}
+	return "", model.NewInvalidParameterError(fmt.Sprintf("collection %q declares no primary key", i.indexBase.CollectionName))
+}
@@ -0,0 +1,91 @@
+// Copyright (c) 2025 Beijing Volcano Engine Technology Co., Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package vector
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"github.com/volcengine/vikingdb-go-sdk/vector/model"
+)
+
+const apiPathPrefix = "/api/vikingdb/"
+
+// CallInfo describes the request an interceptor is invoked for.
+type CallInfo struct {
+	// Operation is the logical operation name, e.g. "data/search/vector".
+	Operation string
+	Method    string
+	Path      string
+	// Collection and IndexName identify the target resource when the request is scoped to one.
+	Collection model.CollectionLocator
+	IndexName  string
+	// Endpoint and Region identify the endpoint chosen for the attempt; the request is signed for Region.
+	Endpoint string
+	Region   string
+	// Attempt is the zero-based retry attempt, or -1 for call interceptors.
+	Attempt int
+	// Request is the request payload before serialization.
+	Request interface{}
+	// Response is the target the reply is decoded into; it is populated once next returns.
+	Response interface{}
+	// HTTPResponse carries the status and headers of the attempt once next returns. Its body has
+	// already been consumed.
+	HTTPResponse *http.Response
+	// RequestID is the X-Tt-Logid echoed by the service once next returns.
+	RequestID string
+}
+
+// Invoker signs and sends req and decodes the reply into info.Response.
+type Invoker func(ctx context.Context, info *CallInfo, req *http.Request) error
+
+// Interceptor wraps every HTTP attempt made by the SDK. It may inspect or replace req before calling next
+// (the request is signed afterwards, so injected headers are covered by the signature), and inspect
+// info.Response, info.HTTPResponse and the returned error afterwards. Returning without calling next
+// short-circuits the attempt.
+type Interceptor func(ctx context.Context, info *CallInfo, req *http.Request, next Invoker) error
+
+// CallInvoker performs a logical call, including every retry attempt.
+type CallInvoker func(ctx context.Context, info *CallInfo) error
+
+// CallInterceptor wraps a logical call once, around all of its retry attempts. The context passed to next
+// is the parent of every attempt, which makes it the place for per-call spans, timers and deadlines.
+type CallInterceptor func(ctx context.Context, info *CallInfo, next CallInvoker) error
+
+// chainInterceptors composes interceptors around invoker; the first interceptor is the outermost.
+func chainInterceptors(interceptors []Interceptor, invoker Invoker) Invoker {
+	for i := len(interceptors) - 1; i >= 0; i-- {
+		interceptor, next := interceptors[i], invoker
+		invoker = func(ctx context.Context, info *CallInfo, req *http.Request) error {
+			return interceptor(ctx, info, req, next)
+		}
+	}
+	return invoker
+}
+
+// collectionScoped and indexScoped are satisfied by requests embedding a model locator.
+type collectionScoped interface {
+	GetCollectionLocator() model.CollectionLocator
+}
+
+type indexScoped interface {
+	GetIndexName() string
+}
+
+// invokeCall runs call through the configured call interceptors; the first interceptor is the outermost.
+func (c *transport) invokeCall(ctx context.Context, info *CallInfo, call CallInvoker) error {
+	for i := len(c.callChain) - 1; i >= 0; i-- {
+		interceptor, next := c.callChain[i], call
+		call = func(ctx context.Context, info *CallInfo) error {
+			return interceptor(ctx, info, next)
+		}
+	}
+	return call(ctx, info)
+}
+
+// operationName derives the logical operation name from an API path.
+func operationName(path string) string {
+	return strings.TrimPrefix(path, apiPathPrefix)
+}
@@ -0,0 +1,83 @@
+// Copyright (c) 2025 Beijing Volcano Engine Technology Co., Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package vector
+
+import (
+	"context"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/volcengine/vikingdb-go-sdk/vector/model"
+	"github.com/volcengine/vikingdb-go-sdk/vector/utils"
+)
+
+func TestAttemptInfoIsNotCarriedOver(t *testing.T) {
+	var hits int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = io.Copy(ioutil.Discard, r.Body)
+		if atomic.AddInt32(&hits, 1) == 1 {
+			w.Header().Set("X-Tt-Logid", "first")
+			w.WriteHeader(http.StatusServiceUnavailable)
+			_, _ = w.Write([]byte(`{"code":"ServiceUnavailable","message":"down"}`))
+			return
+		}
+		// The second attempt gets no reply at all.
+		conn, _, err := w.(http.Hijacker).Hijack()
+		if err == nil {
+			_ = conn.Close()
+		}
+	}))
+	defer srv.Close()
+
+	type observed struct {
+		status    int
+		requestID string
+	}
+	var mu sync.Mutex
+	var attempts []observed
+	var call observed
+	record := func(resp *http.Response, requestID string) observed {
+		o := observed{requestID: requestID}
+		if resp != nil {
+			o.status = resp.StatusCode
+		}
+		return o
+	}
+	client, err := New(AuthAPIKey("key"), WithEndpoint(srv.URL), WithMaxRetries(1),
+		WithRetryPolicy(utils.RetryPolicy{InitialBackoff: time.Millisecond, MaxBackoff: time.Millisecond, Jitter: utils.JitterNone}),
+		WithInterceptors(func(ctx context.Context, info *CallInfo, req *http.Request, next Invoker) error {
+			err := next(ctx, info, req)
+			mu.Lock()
+			attempts = append(attempts, record(info.HTTPResponse, info.RequestID))
+			mu.Unlock()
+			return err
+		}),
+		WithCallInterceptors(func(ctx context.Context, info *CallInfo, next CallInvoker) error {
+			err := next(ctx, info)
+			call = record(info.HTTPResponse, info.RequestID)
+			return err
+		}),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = client.Index(model.IndexLocator{IndexName: "i"}).SearchByVector(context.Background(), model.SearchByVectorRequest{DenseVector: []float64{1}})
+	if err == nil {
+		t.Fatal("expected the connection error")
+	}
+	want := []observed{{status: http.StatusServiceUnavailable, requestID: "first"}, {}}
+	if len(attempts) != 2 || attempts[0] != want[0] || attempts[1] != want[1] {
+		t.Fatalf("attempts = %+v, want %+v", attempts, want)
+	}
+	if call != (observed{}) {
+		t.Fatalf("call reported %+v from an earlier attempt", call)
+	}
+}
@@ -21,6 +21,15 @@ type CollectionClient interface {
 	ProjectName() string
 }
 
+// CollectionAdminClient provides collection schema management (DDL) operations.
+type CollectionAdminClient interface {
+	CreateCollection(ctx context.Context, request model.CreateCollectionRequest, opts ...RequestOption) (*model.CreateCollectionResponse, error)
+	DescribeCollection(ctx context.Context, request model.DescribeCollectionRequest, opts ...RequestOption) (*model.DescribeCollectionResponse, error)
+	ListCollections(ctx context.Context, request model.ListCollectionsRequest, opts ...RequestOption) (*model.ListCollectionsResponse, error)
+	UpdateCollection(ctx context.Context, request model.UpdateCollectionRequest, opts ...RequestOption) (*model.UpdateCollectionResponse, error)
+	DropCollection(ctx context.Context, request model.DropCollectionRequest, opts ...RequestOption) (*model.DropCollectionResponse, error)
+}
+
 // IndexClient provides index-level search and metadata operations.
 type IndexClient interface {
 	Fetch(ctx context.Context, request model.FetchDataInIndexRequest, opts ...RequestOption) (*model.FetchDataInIndexResponse, error)
@@ -38,6 +47,15 @@ type IndexClient interface {
 	ProjectName() string
 }
 
+// IndexAdminClient provides index control-plane operations such as provisioning and teardown.
+type IndexAdminClient interface {
+	CreateIndex(ctx context.Context, request model.CreateIndexRequest, opts ...RequestOption) (*model.CreateIndexResponse, error)
+	GetIndex(ctx context.Context, request model.GetIndexRequest, opts ...RequestOption) (*model.GetIndexResponse, error)
+	ListIndexes(ctx context.Context, request model.ListIndexesRequest, opts ...RequestOption) (*model.ListIndexesResponse, error)
+	UpdateIndex(ctx context.Context, request model.UpdateIndexRequest, opts ...RequestOption) (*model.UpdateIndexResponse, error)
+	DeleteIndex(ctx context.Context, request model.DeleteIndexRequest, opts ...RequestOption) (*model.DeleteIndexResponse, error)
+}
+
 // EmbeddingClient provides embedding operations.
 type EmbeddingClient interface {
 	Embedding(ctx context.Context, request model.EmbeddingRequest, opts ...RequestOption) (*model.EmbeddingResponse, error)
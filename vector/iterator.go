@@ -0,0 +1,277 @@
+// Copyright (c) 2025 Beijing Volcano Engine Technology Co., Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package vector
+
+import (
+	"context"
+
+	"github.com/volcengine/vikingdb-go-sdk/vector/model"
+)
+
+const defaultIteratorPageSize = 100
+
+// IteratorOption tunes how list iterators walk through pages.
+type IteratorOption func(*iteratorOptions)
+
+type iteratorOptions struct {
+	pageSize    int
+	prefetch    bool
+	requestOpts []RequestOption
+}
+
+// WithPageSize overrides the page size requested from the service.
+func WithPageSize(pageSize int) IteratorOption {
+	return func(o *iteratorOptions) {
+		o.pageSize = pageSize
+	}
+}
+
+// WithPrefetch fetches the next page in the background while the current one is consumed.
+// The background request runs under the context of the Next call that started it; if that
+// context is cancelled before the page is consumed, the page is requested again under the
+// context of the Next call that needs it.
+func WithPrefetch() IteratorOption {
+	return func(o *iteratorOptions) {
+		o.prefetch = true
+	}
+}
+
+// WithIteratorRequestOptions applies the request options to every page request.
+func WithIteratorRequestOptions(opts ...RequestOption) IteratorOption {
+	return func(o *iteratorOptions) {
+		o.requestOpts = append(o.requestOpts, opts...)
+	}
+}
+
+// pageFetcher loads one page of items together with the pagination metadata reported by the service.
+type pageFetcher func(ctx context.Context, page, pageSize int) ([]interface{}, model.PaginationResponse, error)
+
+type pageResult struct {
+	items      []interface{}
+	pagination model.PaginationResponse
+	err        error
+}
+
+// pager drives a pageFetcher until the service reports the last page.
+type pager struct {
+	fetch    pageFetcher
+	pageSize int
+	prefetch bool
+
+	page    int
+	buf     []interface{}
+	pos     int
+	current interface{}
+	last    bool
+	err     error
+	pending chan pageResult
+	// pendingCtx is the context the prefetch in pending was started with.
+	pendingCtx context.Context
+}
+
+func newPager(fetch pageFetcher, startPage, pageSize int, opts []IteratorOption) *pager {
+	options := &iteratorOptions{pageSize: pageSize}
+	for _, opt := range opts {
+		opt(options)
+	}
+	if options.pageSize <= 0 {
+		options.pageSize = defaultIteratorPageSize
+	}
+	if startPage <= 0 {
+		startPage = 1
+	}
+	return &pager{
+		fetch:    fetch,
+		pageSize: options.pageSize,
+		prefetch: options.prefetch,
+		page:     startPage,
+	}
+}
+
+func (p *pager) next(ctx context.Context) bool {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	for {
+		if p.err != nil {
+			return false
+		}
+		if err := ctx.Err(); err != nil {
+			p.err = err
+			return false
+		}
+		if p.pos < len(p.buf) {
+			p.current = p.buf[p.pos]
+			p.pos++
+			return true
+		}
+		if p.last {
+			p.current = nil
+			return false
+		}
+
+		var result pageResult
+		if p.pending != nil {
+			select {
+			case result = <-p.pending:
+			case <-ctx.Done():
+				p.err = ctx.Err()
+				return false
+			}
+			if result.err != nil && p.pendingCtx.Err() != nil {
+				// The prefetch was started by an earlier Next whose context has since
+				// been cancelled; its failure says nothing about this call, so reload.
+				result = p.load(ctx, p.page)
+			}
+			p.pending, p.pendingCtx = nil, nil
+		} else {
+			result = p.load(ctx, p.page)
+		}
+		if result.err != nil {
+			p.err = result.err
+			return false
+		}
+
+		p.buf, p.pos = result.items, 0
+		p.last = p.isLastPage(result)
+		p.page++
+		if !p.last && p.prefetch {
+			p.pending, p.pendingCtx = make(chan pageResult, 1), ctx
+			go func(ch chan<- pageResult, page int) {
+				ch <- p.load(ctx, page)
+			}(p.pending, p.page)
+		}
+	}
+}
+
+func (p *pager) load(ctx context.Context, page int) pageResult {
+	items, pagination, err := p.fetch(ctx, page, p.pageSize)
+	return pageResult{items: items, pagination: pagination, err: err}
+}
+
+func (p *pager) isLastPage(result pageResult) bool {
+	if len(result.items) == 0 {
+		return true
+	}
+	pageSize := result.pagination.PageSize
+	if pageSize <= 0 {
+		pageSize = p.pageSize
+	}
+	if result.pagination.Total > 0 {
+		return p.page*pageSize >= result.pagination.Total
+	}
+	return len(result.items) < pageSize
+}
+
+// IndexIterator walks every index returned by ListIndexes, requesting pages as needed.
+type IndexIterator struct {
+	pager *pager
+}
+
+// NewIndexIterator returns an iterator over the indexes matching request.
+// The request's Page and PageSize select the starting page and the page size.
+func NewIndexIterator(admin IndexAdminClient, request model.ListIndexesRequest, opts ...IteratorOption) *IndexIterator {
+	options := &iteratorOptions{}
+	for _, opt := range opts {
+		opt(options)
+	}
+	fetch := func(ctx context.Context, page, pageSize int) ([]interface{}, model.PaginationResponse, error) {
+		req := request
+		req.Page, req.PageSize = page, pageSize
+		resp, err := admin.ListIndexes(ctx, req, options.requestOpts...)
+		if err != nil {
+			return nil, model.PaginationResponse{}, err
+		}
+		items := make([]interface{}, 0, len(resp.Indexes))
+		for _, index := range resp.Indexes {
+			items = append(items, index)
+		}
+		return items, resp.PaginationResponse, nil
+	}
+	return &IndexIterator{pager: newPager(fetch, request.Page, request.PageSize, opts)}
+}
+
+// Next advances to the next index, fetching a new page when required.
+// Cancelling ctx ends the iteration and Err reports the context error.
+func (it *IndexIterator) Next(ctx context.Context) bool {
+	return it.pager.next(ctx)
+}
+
+// Item returns the index at the current position.
+func (it *IndexIterator) Item() *model.Index {
+	index, _ := it.pager.current.(*model.Index)
+	return index
+}
+
+// Err returns the error that stopped the iteration, if any.
+func (it *IndexIterator) Err() error {
+	return it.pager.err
+}
+
+// ForEachIndex calls fn for every index matching request, stopping at the first error.
+func ForEachIndex(ctx context.Context, admin IndexAdminClient, request model.ListIndexesRequest, fn func(*model.Index) error, opts ...IteratorOption) error {
+	it := NewIndexIterator(admin, request, opts...)
+	for it.Next(ctx) {
+		if err := fn(it.Item()); err != nil {
+			return err
+		}
+	}
+	return it.Err()
+}
+
+// CollectionIterator walks every collection returned by ListCollections, requesting pages as needed.
+type CollectionIterator struct {
+	pager *pager
+}
+
+// NewCollectionIterator returns an iterator over the collections matching request.
+// The request's Page and PageSize select the starting page and the page size.
+func NewCollectionIterator(admin CollectionAdminClient, request model.ListCollectionsRequest, opts ...IteratorOption) *CollectionIterator {
+	options := &iteratorOptions{}
+	for _, opt := range opts {
+		opt(options)
+	}
+	fetch := func(ctx context.Context, page, pageSize int) ([]interface{}, model.PaginationResponse, error) {
+		req := request
+		req.Page, req.PageSize = page, pageSize
+		resp, err := admin.ListCollections(ctx, req, options.requestOpts...)
+		if err != nil {
+			return nil, model.PaginationResponse{}, err
+		}
+		items := make([]interface{}, 0, len(resp.Collections))
+		for _, collection := range resp.Collections {
+			items = append(items, collection)
+		}
+		return items, resp.PaginationResponse, nil
+	}
+	return &CollectionIterator{pager: newPager(fetch, request.Page, request.PageSize, opts)}
+}
+
+// Next advances to the next collection, fetching a new page when required.
+// Cancelling ctx ends the iteration and Err reports the context error.
+func (it *CollectionIterator) Next(ctx context.Context) bool {
+	return it.pager.next(ctx)
+}
+
+// Item returns the collection at the current position.
+func (it *CollectionIterator) Item() *model.Collection {
+	collection, _ := it.pager.current.(*model.Collection)
+	return collection
+}
+
+// Err returns the error that stopped the iteration, if any.
+func (it *CollectionIterator) Err() error {
+	return it.pager.err
+}
+
+// ForEachCollection calls fn for every collection matching request, stopping at the first error.
+func ForEachCollection(ctx context.Context, admin CollectionAdminClient, request model.ListCollectionsRequest, fn func(*model.Collection) error, opts ...IteratorOption) error {
+	it := NewCollectionIterator(admin, request, opts...)
+	for it.Next(ctx) {
+		if err := fn(it.Item()); err != nil {
+			return err
+		}
+	}
+	return it.Err()
+}
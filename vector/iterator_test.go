@@ -0,0 +1,178 @@
+// Copyright (c) 2025 Beijing Volcano Engine Technology Co., Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package vector
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/volcengine/vikingdb-go-sdk/vector/model"
+)
+
+// pagedSource serves total integer items, page by page, recording the pages requested.
+type pagedSource struct {
+	mu         sync.Mutex
+	total      int
+	reportSize bool
+	calls      []int
+}
+
+func (s *pagedSource) fetch(ctx context.Context, page, pageSize int) ([]interface{}, model.PaginationResponse, error) {
+	s.mu.Lock()
+	s.calls = append(s.calls, page)
+	s.mu.Unlock()
+	if err := ctx.Err(); err != nil {
+		return nil, model.PaginationResponse{}, err
+	}
+	var items []interface{}
+	for i := (page - 1) * pageSize; i < page*pageSize && i < s.total; i++ {
+		items = append(items, i)
+	}
+	pagination := model.PaginationResponse{Page: page, PageSize: pageSize}
+	if s.reportSize {
+		pagination.Total = s.total
+	}
+	return items, pagination, nil
+}
+
+func (s *pagedSource) pages() []int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]int(nil), s.calls...)
+}
+
+func TestPagerStopsAtLastPage(t *testing.T) {
+	cases := []struct {
+		name       string
+		total      int
+		pageSize   int
+		reportSize bool
+		wantPages  []int
+	}{
+		{name: "total exact multiple", total: 6, pageSize: 3, reportSize: true, wantPages: []int{1, 2}},
+		{name: "total partial page", total: 7, pageSize: 3, reportSize: true, wantPages: []int{1, 2, 3}},
+		{name: "short page without total", total: 7, pageSize: 3, wantPages: []int{1, 2, 3}},
+		{name: "empty page without total", total: 6, pageSize: 3, wantPages: []int{1, 2, 3}},
+		{name: "no items", total: 0, pageSize: 3, reportSize: true, wantPages: []int{1}},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			for _, prefetch := range []bool{false, true} {
+				src := &pagedSource{total: tc.total, reportSize: tc.reportSize}
+				var opts []IteratorOption
+				if prefetch {
+					opts = append(opts, WithPrefetch())
+				}
+				p := newPager(src.fetch, 1, tc.pageSize, opts)
+				got := 0
+				for p.next(context.Background()) {
+					if p.current.(int) != got {
+						t.Fatalf("prefetch=%v: item %d = %v", prefetch, got, p.current)
+					}
+					got++
+				}
+				if p.err != nil {
+					t.Fatalf("prefetch=%v: unexpected error: %v", prefetch, p.err)
+				}
+				if got != tc.total {
+					t.Fatalf("prefetch=%v: got %d items, want %d", prefetch, got, tc.total)
+				}
+				if pages := src.pages(); !equalInts(pages, tc.wantPages) {
+					t.Fatalf("prefetch=%v: requested pages %v, want %v", prefetch, pages, tc.wantPages)
+				}
+			}
+		})
+	}
+}
+
+func TestPagerStopsOnCancellation(t *testing.T) {
+	src := &pagedSource{total: 10, reportSize: true}
+	p := newPager(src.fetch, 1, 2, nil)
+	ctx, cancel := context.WithCancel(context.Background())
+	if !p.next(ctx) {
+		t.Fatalf("first item not returned: %v", p.err)
+	}
+	cancel()
+	if p.next(ctx) {
+		t.Fatal("next succeeded after cancellation")
+	}
+	if !errors.Is(p.err, context.Canceled) {
+		t.Fatalf("err = %v, want context.Canceled", p.err)
+	}
+	if p.next(context.Background()) {
+		t.Fatal("iteration resumed after it was stopped")
+	}
+}
+
+func TestPagerReloadsPrefetchFromCancelledContext(t *testing.T) {
+	src := &pagedSource{total: 4, reportSize: true}
+	var once sync.Once
+	fetch := func(ctx context.Context, page, pageSize int) ([]interface{}, model.PaginationResponse, error) {
+		blocked := false
+		if page == 2 {
+			once.Do(func() { blocked = true })
+		}
+		if blocked {
+			// The first request for page 2 is the prefetch; hold it until its context dies.
+			<-ctx.Done()
+			return nil, model.PaginationResponse{}, ctx.Err()
+		}
+		return src.fetch(ctx, page, pageSize)
+	}
+	p := newPager(fetch, 1, 2, []IteratorOption{WithPrefetch()})
+
+	first, cancel := context.WithCancel(context.Background())
+	if !p.next(first) {
+		t.Fatalf("first item not returned: %v", p.err)
+	}
+	got := []int{p.current.(int)}
+	cancel()
+
+	for p.next(context.Background()) {
+		got = append(got, p.current.(int))
+	}
+	if p.err != nil {
+		t.Fatalf("error from cancelled prefetch leaked into a live Next: %v", p.err)
+	}
+	if !equalInts(got, []int{0, 1, 2, 3}) {
+		t.Fatalf("items = %v", got)
+	}
+}
+
+func TestPagerSurfacesFetchError(t *testing.T) {
+	boom := errors.New("boom")
+	calls := 0
+	fetch := func(ctx context.Context, page, pageSize int) ([]interface{}, model.PaginationResponse, error) {
+		calls++
+		if page == 2 {
+			return nil, model.PaginationResponse{}, boom
+		}
+		return []interface{}{1, 2}, model.PaginationResponse{Total: 10}, nil
+	}
+	p := newPager(fetch, 1, 2, nil)
+	n := 0
+	for p.next(context.Background()) {
+		n++
+	}
+	if n != 2 || !errors.Is(p.err, boom) {
+		t.Fatalf("n = %d, err = %v", n, p.err)
+	}
+	if p.next(context.Background()) || calls != 2 {
+		t.Fatalf("iteration continued after error: calls = %d", calls)
+	}
+}
+
+func equalInts(a, b []int) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,227 @@
+// Copyright (c) 2025 Beijing Volcano Engine Technology Co., Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package vector
+
+import (
+	"context"
+	"math"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/volcengine/vikingdb-go-sdk/vector/model"
+)
+
+// EndpointFamily groups API paths that share client-side budgets.
+type EndpointFamily string
+
+const (
+	// FamilyWrite covers data/upsert, data/update and data/delete.
+	FamilyWrite EndpointFamily = "write"
+	// FamilySearch covers data/search/*, data/agg and the fetch endpoints.
+	FamilySearch EndpointFamily = "search"
+	// FamilyEmbedding covers the embedding endpoint.
+	FamilyEmbedding EndpointFamily = "embedding"
+	// FamilyRerank covers the rerank endpoint.
+	FamilyRerank EndpointFamily = "rerank"
+	// FamilyOther covers everything else, including control-plane calls.
+	FamilyOther EndpointFamily = "other"
+)
+
+// EndpointFamilyOf classifies an API path.
+func EndpointFamilyOf(path string) EndpointFamily {
+	switch op := operationName(path); {
+	case op == "data/upsert" || op == "data/update" || op == "data/delete":
+		return FamilyWrite
+	case strings.HasPrefix(op, "data/search/") || op == "data/agg" || strings.HasPrefix(op, "data/fetch_"):
+		return FamilySearch
+	case op == "embedding":
+		return FamilyEmbedding
+	case op == "rerank":
+		return FamilyRerank
+	}
+	return FamilyOther
+}
+
+// RateLimit describes the client-side budget for an endpoint family. Zero fields disable the matching limit.
+//
+// Budgets are charged per HTTP attempt rather than per logical call: every retry takes another token and
+// holds an in-flight slot while it runs, so retries are throttled together with first attempts.
+type RateLimit struct {
+	// RequestsPerSecond is the steady-state token bucket refill rate.
+	RequestsPerSecond float64
+	// Burst is the bucket capacity; it defaults to RequestsPerSecond rounded up.
+	Burst int
+	// MaxInFlight bounds the number of concurrent HTTP attempts.
+	MaxInFlight int
+
+	// Adaptive halves the rate when the service answers 429 and raises it again by IncreaseStep on every
+	// success, up to RequestsPerSecond (additive increase, multiplicative decrease). A burst of 429s halves
+	// the rate once: only attempts started after the last decrease can lower it again.
+	Adaptive bool
+	// MinRequestsPerSecond is the floor for adaptive decreases; it defaults to a tenth of the rate.
+	MinRequestsPerSecond float64
+	// IncreaseStep is the per-success additive increase; it defaults to a hundredth of the rate.
+	IncreaseStep float64
+}
+
+// WithRateLimit installs a client-side rate and concurrency budget for an endpoint family.
+// Waiting for the budget honours the request context.
+func WithRateLimit(family EndpointFamily, limit RateLimit) ClientOption {
+	return func(c *Config) {
+		if c.RateLimits == nil {
+			c.RateLimits = make(map[EndpointFamily]RateLimit)
+		}
+		c.RateLimits[family] = limit
+	}
+}
+
+// familyLimiter enforces a RateLimit for one endpoint family.
+type familyLimiter struct {
+	limit  RateLimit
+	bucket *tokenBucket
+	slots  chan struct{}
+}
+
+func newRateLimitInterceptor(limits map[EndpointFamily]RateLimit) Interceptor {
+	limiters := make(map[EndpointFamily]*familyLimiter, len(limits))
+	for family, limit := range limits {
+		limiter := &familyLimiter{limit: limit}
+		if limit.RequestsPerSecond > 0 {
+			limiter.bucket = newTokenBucket(limit)
+		}
+		if limit.MaxInFlight > 0 {
+			limiter.slots = make(chan struct{}, limit.MaxInFlight)
+		}
+		limiters[family] = limiter
+	}
+
+	return func(ctx context.Context, info *CallInfo, req *http.Request, next Invoker) error {
+		limiter, ok := limiters[EndpointFamilyOf(info.Path)]
+		if !ok {
+			return next(ctx, info, req)
+		}
+		release, err := limiter.acquire(ctx)
+		if err != nil {
+			return err
+		}
+		defer release()
+
+		started := time.Now()
+		err = next(ctx, info, req)
+		if limiter.bucket != nil && limiter.limit.Adaptive {
+			if isThrottled(err) {
+				limiter.bucket.decrease(started)
+			} else if err == nil {
+				limiter.bucket.increase()
+			}
+		}
+		return err
+	}
+}
+
+// acquire waits for a token and then for an in-flight slot. Taking the token first keeps callers that
+// are still waiting on the rate from holding slots idle.
+func (l *familyLimiter) acquire(ctx context.Context) (func(), error) {
+	if l.bucket != nil {
+		if err := l.bucket.wait(ctx); err != nil {
+			return nil, err
+		}
+	}
+	if l.slots == nil {
+		return func() {}, nil
+	}
+	select {
+	case l.slots <- struct{}{}:
+		return func() { <-l.slots }, nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+func isThrottled(err error) bool {
+	sdkErr, ok := err.(*model.Error)
+	return ok && (sdkErr.StatusCode == http.StatusTooManyRequests || sdkErr.Code == model.ErrCodeRequestLimitExceeded)
+}
+
+// tokenBucket is a token bucket whose refill rate can be adjusted at runtime.
+type tokenBucket struct {
+	mu      sync.Mutex
+	rate    float64
+	maxRate float64
+	minRate float64
+	step    float64
+	burst   float64
+	tokens  float64
+	last    time.Time
+	// decreasedAt is when the rate was last lowered.
+	decreasedAt time.Time
+}
+
+func newTokenBucket(limit RateLimit) *tokenBucket {
+	burst := float64(limit.Burst)
+	if burst <= 0 {
+		burst = math.Ceil(limit.RequestsPerSecond)
+	}
+	minRate := limit.MinRequestsPerSecond
+	if minRate <= 0 || minRate > limit.RequestsPerSecond {
+		minRate = limit.RequestsPerSecond / 10
+	}
+	step := limit.IncreaseStep
+	if step <= 0 {
+		step = limit.RequestsPerSecond / 100
+	}
+	return &tokenBucket{
+		rate:    limit.RequestsPerSecond,
+		maxRate: limit.RequestsPerSecond,
+		minRate: minRate,
+		step:    step,
+		burst:   burst,
+		tokens:  burst,
+		last:    time.Now(),
+	}
+}
+
+// wait blocks until a token is available or ctx is done.
+func (b *tokenBucket) wait(ctx context.Context) error {
+	for {
+		b.mu.Lock()
+		now := time.Now()
+		b.tokens = math.Min(b.burst, b.tokens+now.Sub(b.last).Seconds()*b.rate)
+		b.last = now
+		if b.tokens >= 1 {
+			b.tokens--
+			b.mu.Unlock()
+			return nil
+		}
+		delay := time.Duration((1 - b.tokens) / b.rate * float64(time.Second))
+		b.mu.Unlock()
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// decrease halves the rate after a 429 on an attempt that started at started. Attempts already in flight
+// when the rate was last lowered were sent at the old rate, so their 429s do not lower it again.
+func (b *tokenBucket) decrease(started time.Time) {
+	b.mu.Lock()
+	if !started.Before(b.decreasedAt) {
+		b.rate = math.Max(b.minRate, b.rate/2)
+		b.decreasedAt = time.Now()
+	}
+	b.mu.Unlock()
+}
+
+func (b *tokenBucket) increase() {
+	b.mu.Lock()
+	b.rate = math.Min(b.maxRate, b.rate+b.step)
+	b.mu.Unlock()
+}
@@ -0,0 +1,180 @@
+// Copyright (c) 2025 Beijing Volcano Engine Technology Co., Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package vector
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/volcengine/vikingdb-go-sdk/vector/model"
+)
+
+func TestEndpointFamilyOf(t *testing.T) {
+	cases := map[string]EndpointFamily{
+		"/api/vikingdb/data/upsert":             FamilyWrite,
+		"/api/vikingdb/data/delete":             FamilyWrite,
+		"/api/vikingdb/data/search/vector":      FamilySearch,
+		"/api/vikingdb/data/agg":                FamilySearch,
+		"/api/vikingdb/data/fetch_in_index":     FamilySearch,
+		"/api/vikingdb/embedding":               FamilyEmbedding,
+		"/api/vikingdb/rerank":                  FamilyRerank,
+		"/api/vikingdb/collection/create":       FamilyOther,
+		"/api/vikingdb/data/search_unsupported": FamilyOther,
+	}
+	for path, want := range cases {
+		if got := EndpointFamilyOf(path); got != want {
+			t.Errorf("EndpointFamilyOf(%q) = %s, want %s", path, got, want)
+		}
+	}
+}
+
+func TestTokenBucket(t *testing.T) {
+	bucket := newTokenBucket(RateLimit{RequestsPerSecond: 20, Burst: 2})
+	start := time.Now()
+	for i := 0; i < 3; i++ {
+		if err := bucket.wait(context.Background()); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// The burst covers two calls; the third waits for a refill of 1/20s.
+	if elapsed := time.Since(start); elapsed < 40*time.Millisecond {
+		t.Fatalf("three tokens taken in %v, want the third to wait for a refill", elapsed)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Millisecond)
+	defer cancel()
+	if err := bucket.wait(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("err = %v, want deadline exceeded", err)
+	}
+}
+
+func TestTokenBucketAdaptiveRate(t *testing.T) {
+	bucket := newTokenBucket(RateLimit{RequestsPerSecond: 100, MinRequestsPerSecond: 30, IncreaseStep: 10})
+	throttle := func() { bucket.decrease(time.Now()) }
+	steps := []struct {
+		name string
+		fn   func()
+		want float64
+	}{
+		{name: "halved on throttle", fn: throttle, want: 50},
+		{name: "floored at the minimum", fn: throttle, want: 30},
+		{name: "stays at the minimum", fn: throttle, want: 30},
+		{name: "additive increase", fn: bucket.increase, want: 40},
+	}
+	for _, step := range steps {
+		step.fn()
+		if bucket.rate != step.want {
+			t.Fatalf("%s: rate = %v, want %v", step.name, bucket.rate, step.want)
+		}
+	}
+	for i := 0; i < 20; i++ {
+		bucket.increase()
+	}
+	if bucket.rate != 100 {
+		t.Fatalf("rate = %v, want it capped at 100", bucket.rate)
+	}
+}
+
+func TestTokenBucketDecreasesOncePerBurst(t *testing.T) {
+	bucket := newTokenBucket(RateLimit{RequestsPerSecond: 100, MinRequestsPerSecond: 1})
+	// Four attempts are in flight at the same rate and all come back throttled.
+	started := time.Now()
+	for i := 0; i < 4; i++ {
+		bucket.decrease(started)
+	}
+	if bucket.rate != 50 {
+		t.Fatalf("rate = %v after one burst of 429s, want 50", bucket.rate)
+	}
+	// An attempt sent at the lowered rate is still throttled, so the rate drops again.
+	bucket.decrease(time.Now())
+	if bucket.rate != 25 {
+		t.Fatalf("rate = %v, want 25", bucket.rate)
+	}
+}
+
+func TestLimiterTakesTokenBeforeSlot(t *testing.T) {
+	limiter := &familyLimiter{
+		bucket: newTokenBucket(RateLimit{RequestsPerSecond: 0.001, Burst: 1}),
+		slots:  make(chan struct{}, 1),
+	}
+	release, err := limiter.acquire(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	release()
+
+	// The bucket is now empty for a long time; a waiting caller must not sit on the only slot.
+	ctx, cancel := context.WithCancel(context.Background())
+	waiting := make(chan error, 1)
+	go func() {
+		_, err := limiter.acquire(ctx)
+		waiting <- err
+	}()
+	time.Sleep(10 * time.Millisecond)
+	if held := len(limiter.slots); held != 0 {
+		t.Fatalf("caller waiting for a token holds %d slot(s)", held)
+	}
+	cancel()
+	if err := <-waiting; !errors.Is(err, context.Canceled) {
+		t.Fatalf("err = %v, want context.Canceled", err)
+	}
+}
+
+func TestRateLimitInterceptor(t *testing.T) {
+	const maxInFlight = 2
+	intercept := newRateLimitInterceptor(map[EndpointFamily]RateLimit{
+		FamilySearch: {MaxInFlight: maxInFlight},
+		FamilyWrite:  {RequestsPerSecond: 1000, Adaptive: true},
+	})
+
+	var inFlight, peak int64
+	slow := func(ctx context.Context, info *CallInfo, req *http.Request) error {
+		n := atomic.AddInt64(&inFlight, 1)
+		for {
+			p := atomic.LoadInt64(&peak)
+			if n <= p || atomic.CompareAndSwapInt64(&peak, p, n) {
+				break
+			}
+		}
+		time.Sleep(5 * time.Millisecond)
+		atomic.AddInt64(&inFlight, -1)
+		return nil
+	}
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			info := &CallInfo{Path: "/api/vikingdb/data/search/vector"}
+			if err := intercept(context.Background(), info, nil, slow); err != nil {
+				t.Error(err)
+			}
+		}()
+	}
+	wg.Wait()
+	if peak > maxInFlight {
+		t.Fatalf("%d attempts ran concurrently, want at most %d", peak, maxInFlight)
+	}
+
+	// Throttled replies reach the caller unchanged while the adaptive bucket backs off.
+	throttled := func(ctx context.Context, info *CallInfo, req *http.Request) error {
+		return model.NewErrorWithStatusCode(model.ErrCodeRequestLimitExceeded, "slow down", http.StatusTooManyRequests)
+	}
+	info := &CallInfo{Path: "/api/vikingdb/data/upsert"}
+	for attempt := 0; attempt < 3; attempt++ {
+		info.Attempt = attempt
+		if err := intercept(context.Background(), info, nil, throttled); !isThrottled(err) {
+			t.Fatalf("err = %v", err)
+		}
+	}
+	unlimited := &CallInfo{Path: "/api/vikingdb/collection/list"}
+	if err := intercept(context.Background(), unlimited, nil, func(context.Context, *CallInfo, *http.Request) error { return nil }); err != nil {
+		t.Fatalf("family without a limit: %v", err)
+	}
+}
@@ -0,0 +1,119 @@
+// Copyright (c) 2025 Beijing Volcano Engine Technology Co., Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package model
+
+import "time"
+
+// FieldType enumerates the scalar and vector field types supported by collection schemas.
+type FieldType string
+
+const (
+	FieldTypeInt64        FieldType = "int64"
+	FieldTypeFloat32      FieldType = "float32"
+	FieldTypeString       FieldType = "string"
+	FieldTypeBool         FieldType = "bool"
+	FieldTypeListString   FieldType = "list<string>"
+	FieldTypeListInt64    FieldType = "list<int64>"
+	FieldTypeVector       FieldType = "vector"
+	FieldTypeSparseVector FieldType = "sparse_vector"
+	FieldTypeText         FieldType = "text"
+	FieldTypeDateTime     FieldType = "date_time"
+	FieldTypeGeoPoint     FieldType = "geo_point"
+)
+
+// FieldSchema describes a single field of a collection.
+type FieldSchema struct {
+	FieldName    string      `json:"field_name"`
+	FieldType    FieldType   `json:"field_type"`
+	IsPrimaryKey bool        `json:"is_primary_key,omitempty"`
+	Dim          int         `json:"dim,omitempty"` // required for vector fields
+	DefaultValue interface{} `json:"default_value,omitempty"`
+}
+
+// VectorizeModelConf configures server-side vectorization for one vector kind.
+type VectorizeModelConf struct {
+	ModelName    string `json:"model_name"`
+	ModelVersion string `json:"model_version,omitempty"`
+	Dim          int    `json:"dim,omitempty"`
+	TextField    string `json:"text_field,omitempty"`
+	ImageField   string `json:"image_field,omitempty"`
+	VideoField   string `json:"video_field,omitempty"`
+}
+
+// VectorizeConfig pairs the dense and sparse vectorization settings of a collection.
+type VectorizeConfig struct {
+	Dense  *VectorizeModelConf `json:"dense,omitempty"`
+	Sparse *VectorizeModelConf `json:"sparse,omitempty"`
+}
+
+// Collection describes a collection and its schema.
+type Collection struct {
+	CollectionName string           `json:"collection_name"`
+	ProjectName    string           `json:"project_name,omitempty"`
+	ResourceID     string           `json:"resource_id,omitempty"`
+	Description    string           `json:"description,omitempty"`
+	Fields         []FieldSchema    `json:"fields,omitempty"`
+	Vectorize      *VectorizeConfig `json:"vectorize,omitempty"`
+	IndexNames     []string         `json:"index_names,omitempty"`
+	CreatedAt      *time.Time       `json:"created_at,omitempty"`
+	UpdatedAt      *time.Time       `json:"updated_at,omitempty"`
+}
+
+// CreateCollectionRequest creates a collection with the given schema.
+type CreateCollectionRequest struct {
+	CollectionName string           `json:"collection_name"`
+	ProjectName    string           `json:"project_name,omitempty"`
+	Description    string           `json:"description,omitempty"`
+	Fields         []FieldSchema    `json:"fields"`
+	Vectorize      *VectorizeConfig `json:"vectorize,omitempty"`
+}
+
+type CreateCollectionResponse struct {
+	CommonResponse
+	Collection *Collection `json:"collection,omitempty"`
+}
+
+// DescribeCollectionRequest fetches a collection's schema and metadata.
+type DescribeCollectionRequest struct {
+	CollectionLocator
+}
+
+type DescribeCollectionResponse struct {
+	CommonResponse
+	Collection *Collection `json:"collection,omitempty"`
+}
+
+// ListCollectionsRequest lists the collections of a project.
+type ListCollectionsRequest struct {
+	ProjectName string `json:"project_name,omitempty"`
+	PaginationRequest
+	NamePrefix string `json:"name_prefix,omitempty"`
+}
+
+type ListCollectionsResponse struct {
+	CommonResponse
+	PaginationResponse
+	Collections []*Collection `json:"collections,omitempty"`
+}
+
+// UpdateCollectionRequest updates the description and appends new fields to a collection.
+type UpdateCollectionRequest struct {
+	CollectionLocator
+	Description *string       `json:"description,omitempty"`
+	Fields      []FieldSchema `json:"fields,omitempty"`
+}
+
+type UpdateCollectionResponse struct {
+	CommonResponse
+	Collection *Collection `json:"collection,omitempty"`
+}
+
+// DropCollectionRequest deletes a collection together with its data and indexes.
+type DropCollectionRequest struct {
+	CollectionLocator
+}
+
+type DropCollectionResponse struct {
+	CommonResponse
+}
@@ -18,12 +18,22 @@ type CollectionLocator struct {
 	ResourceID     string `json:"resource_id"`
 }
 
+// GetCollectionLocator returns the locator itself, so requests embedding a locator expose it.
+func (l CollectionLocator) GetCollectionLocator() CollectionLocator {
+	return l
+}
+
 // IndexLocator extends collection metadata with the index name.
 type IndexLocator struct {
 	CollectionLocator
 	IndexName string `json:"index_name"`
 }
 
+// GetIndexName returns the index name, so requests embedding a locator expose it.
+func (l IndexLocator) GetIndexName() string {
+	return l.IndexName
+}
+
 type Refer struct {
 	AccountID  string `json:"account_id"`
 	InstanceNO string `json:"instance_no"`
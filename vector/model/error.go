@@ -6,6 +6,7 @@ package model
 import (
 	"fmt"
 	"net/http"
+	"time"
 )
 
 // ErrorCode represents the service error code string returned by VikingDB.
@@ -25,6 +26,7 @@ const (
 	ErrCodeUnauthorized         ErrorCode = "Unauthorized"
 	ErrCodeForbidden            ErrorCode = "Forbidden"
 	ErrCodeNotFound             ErrorCode = "NotFound"
+	ErrCodeCircuitOpen          ErrorCode = "CircuitOpen"
 
 	// Collection related errors.
 	ErrCodeCollectionNotExists     ErrorCode = "CollectionNotExists"
@@ -62,6 +64,9 @@ type Error struct {
 	// RequestID echoes the server-side request identifier.
 	RequestID string `json:"request_id,omitempty"`
 
+	// RetryAfter is the wait requested by the service through the Retry-After header, if any.
+	RetryAfter time.Duration `json:"-"`
+
 	// Err contains the underlying error when available.
 	Err error `json:"-"`
 }
@@ -128,6 +133,11 @@ func IsRetryableError(err error) bool {
 		return false
 	}
 
+	// An open circuit breaker is meant to fail fast.
+	if sdkErr.Code == ErrCodeCircuitOpen {
+		return false
+	}
+
 	switch sdkErr.StatusCode {
 	case http.StatusTooManyRequests,
 		http.StatusInternalServerError, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
@@ -176,3 +186,9 @@ func NewTimeoutError(message string) *Error {
 func NewRequestLimitExceededError(message string) *Error {
 	return NewErrorWithStatusCode(ErrCodeRequestLimitExceeded, message, http.StatusTooManyRequests)
 }
+
+// NewCircuitOpenError returns the error reported while a client-side circuit breaker is open. It carries
+// no HTTP status because the request never reached the service.
+func NewCircuitOpenError(message string) *Error {
+	return NewErrorWithStatusCode(ErrCodeCircuitOpen, message, 0)
+}
@@ -3,12 +3,19 @@
 
 package vector
 
+import (
+	"github.com/volcengine/vikingdb-go-sdk/internal/core"
+	"github.com/volcengine/vikingdb-go-sdk/vector/utils"
+)
+
 // RequestOptions captures per-request overrides for retries, headers, and query params.
 type RequestOptions struct {
-	MaxRetries int
-	Headers    map[string]string
-	Query      map[string]string
-	RequestID  string
+	MaxRetries  int
+	RetryPolicy *utils.RetryPolicy
+	Headers     map[string]string
+	Query       map[string]string
+	RequestID   string
+	Hedge       *HedgePolicy
 }
 
 // RequestOption mutates RequestOptions when constructing a request.
@@ -22,6 +29,17 @@ func defaultRequestOptions() *RequestOptions {
 	}
 }
 
+// toCore returns the subset of the options understood by the shared transport.
+func (o *RequestOptions) toCore() *core.RequestOptions {
+	return &core.RequestOptions{
+		MaxRetries:  o.MaxRetries,
+		RetryPolicy: o.RetryPolicy,
+		Headers:     o.Headers,
+		Query:       o.Query,
+		RequestID:   o.RequestID,
+	}
+}
+
 // WithRequestMaxRetries limits the retry count for the current request.
 func WithRequestMaxRetries(maxRetries int) RequestOption {
 	return func(o *RequestOptions) {
@@ -29,6 +47,13 @@ func WithRequestMaxRetries(maxRetries int) RequestOption {
 	}
 }
 
+// WithRequestRetryPolicy overrides the client retry policy for the current request.
+func WithRequestRetryPolicy(policy utils.RetryPolicy) RequestOption {
+	return func(o *RequestOptions) {
+		o.RetryPolicy = &policy
+	}
+}
+
 // WithRequestHeader sets a single header value for the request.
 func WithRequestHeader(key, value string) RequestOption {
 	return func(o *RequestOptions) {
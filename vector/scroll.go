@@ -0,0 +1,310 @@
+// Copyright (c) 2025 Beijing Volcano Engine Technology Co., Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package vector
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"reflect"
+
+	"github.com/volcengine/vikingdb-go-sdk/vector/filter"
+	"github.com/volcengine/vikingdb-go-sdk/vector/model"
+)
+
+const defaultScrollPageSize = 100
+
+// ScrollOptions configures a Scroller.
+type ScrollOptions struct {
+	// Field is the scalar field the scroll is ordered on. Its values must be unique across the documents
+	// visited, because each page resumes strictly after the last value seen. When empty, the scroll is
+	// ordered on the primary key, which is looked up from the collection schema; this requires an index
+	// client returned by Client.Index.
+	Field string
+	// Filter restricts the documents visited.
+	Filter filter.Filter
+	// OutputFields selects the fields returned for each document. Field is appended when missing.
+	OutputFields []string
+	Partition    string
+	// PageSize is the number of documents requested per SearchByScalar call.
+	PageSize int
+	// RequestOptions are applied to every page request.
+	RequestOptions []RequestOption
+}
+
+// Scroller enumerates every document of an index in ascending order of a scalar field. Pages are
+// requested with keyset filters on the last value seen instead of a growing offset, so every page costs
+// the same regardless of how deep the scroll is.
+type Scroller struct {
+	index   IndexClient
+	options ScrollOptions
+
+	buf     []model.SearchItemResult
+	pos     int
+	current model.SearchItemResult
+	started bool
+	done    bool
+	err     error
+
+	lastValue interface{}
+
+	primaryKey  string
+	keyResolved bool
+}
+
+// primaryKeyResolver is implemented by index clients that can look up the primary key of their collection.
+type primaryKeyResolver interface {
+	primaryKey(ctx context.Context, opts ...RequestOption) (string, error)
+}
+
+// NewScroller returns a Scroller over index.
+func NewScroller(index IndexClient, options ScrollOptions) *Scroller {
+	if options.PageSize <= 0 {
+		options.PageSize = defaultScrollPageSize
+	}
+	if len(options.OutputFields) > 0 && options.Field != "" {
+		found := false
+		for _, f := range options.OutputFields {
+			if f == options.Field {
+				found = true
+				break
+			}
+		}
+		if !found {
+			options.OutputFields = append(append([]string(nil), options.OutputFields...), options.Field)
+		}
+	}
+	return &Scroller{index: index, options: options}
+}
+
+// Next advances to the next document, requesting a new page when required.
+func (s *Scroller) Next(ctx context.Context) bool {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	for {
+		if s.err != nil {
+			return false
+		}
+		if err := ctx.Err(); err != nil {
+			s.err = err
+			return false
+		}
+		if s.pos < len(s.buf) {
+			s.current = s.buf[s.pos]
+			s.pos++
+			return true
+		}
+		if s.done {
+			return false
+		}
+		if err := s.loadPage(ctx); err != nil {
+			s.err = err
+			return false
+		}
+	}
+}
+
+// Item returns the document at the current position.
+func (s *Scroller) Item() model.SearchItemResult {
+	return s.current
+}
+
+// Err returns the error that stopped the scroll, if any.
+func (s *Scroller) Err() error {
+	return s.err
+}
+
+// ForEach calls fn for every remaining document, stopping at the first error.
+func (s *Scroller) ForEach(ctx context.Context, fn func(model.SearchItemResult) error) error {
+	for s.Next(ctx) {
+		if err := fn(s.Item()); err != nil {
+			return err
+		}
+	}
+	return s.Err()
+}
+
+// Stream scrolls in a background goroutine and delivers documents on the returned channel, which is
+// closed when the scroll ends. The error channel yields at most one error and is then closed.
+func (s *Scroller) Stream(ctx context.Context, buffer int) (<-chan model.SearchItemResult, <-chan error) {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	items := make(chan model.SearchItemResult, buffer)
+	errs := make(chan error, 1)
+	go func() {
+		defer close(errs)
+		defer close(items)
+		err := s.ForEach(ctx, func(item model.SearchItemResult) error {
+			select {
+			case items <- item:
+				return nil
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		})
+		if err != nil {
+			errs <- err
+		}
+	}()
+	return items, errs
+}
+
+func (s *Scroller) loadPage(ctx context.Context) error {
+	if s.options.Field == "" {
+		if _, ok := s.index.(primaryKeyResolver); !ok {
+			return model.NewInvalidParameterError("scroll field cannot be empty: this index client cannot look up the primary key")
+		}
+		key, err := s.resolvePrimaryKey(ctx)
+		if err != nil {
+			return err
+		}
+		s.options.Field = key
+	}
+
+	cond := s.options.Filter
+	if s.started {
+		cond = filter.And(cond, filter.Range(s.options.Field, filter.Gt(s.lastValue)))
+	}
+	built, err := cond.Build()
+	if err != nil {
+		return err
+	}
+
+	field := s.options.Field
+	limit := s.options.PageSize
+	resp, err := s.index.SearchByScalar(ctx, model.SearchByScalarRequest{
+		SearchBase: model.SearchBase{
+			RecallBase:   model.RecallBase{Filter: built, Partition: s.options.Partition},
+			OutputFields: s.options.OutputFields,
+			Limit:        &limit,
+		},
+		Field: &field,
+		Order: model.ScalarOrderAsc,
+	}, s.options.RequestOptions...)
+	if err != nil {
+		return err
+	}
+
+	var page []model.SearchItemResult
+	if resp != nil && resp.Result != nil {
+		page = resp.Result.Data
+	}
+	s.buf, s.pos = page, 0
+	if len(page) < limit {
+		s.done = true
+	}
+
+	for _, item := range page {
+		value, err := s.orderValue(ctx, item)
+		if err != nil {
+			return err
+		}
+		if s.started && scrollValuesEqual(value, s.lastValue) {
+			// Resuming after a repeated value would skip the documents sharing it on the next page.
+			return model.NewInvalidParameterError(fmt.Sprintf("scroll field %q is not unique: value %v repeats", field, value))
+		}
+		s.lastValue = value
+		s.started = true
+	}
+	return nil
+}
+
+// orderValue returns the value of the scroll field for item. The service reports the primary key as the
+// item ID rather than in Fields, so a field missing from Fields is only accepted when it is the primary key.
+func (s *Scroller) orderValue(ctx context.Context, item model.SearchItemResult) (interface{}, error) {
+	field := s.options.Field
+	if value, ok := item.Fields[field]; ok && value != nil {
+		return value, nil
+	}
+	if _, ok := s.index.(primaryKeyResolver); ok && item.ID != nil {
+		key, err := s.resolvePrimaryKey(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if key == field {
+			return item.ID, nil
+		}
+	}
+	return nil, model.NewInvalidParameterError(fmt.Sprintf("scroll field %q missing from document %v", field, item.ID))
+}
+
+// resolvePrimaryKey looks up the primary key once per scroll.
+func (s *Scroller) resolvePrimaryKey(ctx context.Context) (string, error) {
+	if !s.keyResolved {
+		key, err := s.index.(primaryKeyResolver).primaryKey(ctx, s.options.RequestOptions...)
+		if err != nil {
+			return "", err
+		}
+		s.primaryKey, s.keyResolved = key, true
+	}
+	return s.primaryKey, nil
+}
+
+// scrollValuesEqual compares two ordering values without conflating types: numbers compare by value
+// whatever their Go representation, anything else must match in both type and value.
+func scrollValuesEqual(a, b interface{}) bool {
+	an, aNumeric := asOrderNumber(a)
+	bn, bNumeric := asOrderNumber(b)
+	if aNumeric || bNumeric {
+		return aNumeric && bNumeric && an.equal(bn)
+	}
+	return reflect.DeepEqual(a, b)
+}
+
+// orderNumber holds an integer exactly and falls back to float64 for everything else.
+type orderNumber struct {
+	i       int64
+	f       float64
+	integer bool
+}
+
+func (n orderNumber) equal(o orderNumber) bool {
+	if n.integer && o.integer {
+		return n.i == o.i
+	}
+	return n.float() == o.float()
+}
+
+func (n orderNumber) float() float64 {
+	if n.integer {
+		return float64(n.i)
+	}
+	return n.f
+}
+
+func asOrderNumber(value interface{}) (orderNumber, bool) {
+	switch v := value.(type) {
+	case int:
+		return orderNumber{i: int64(v), integer: true}, true
+	case int32:
+		return orderNumber{i: int64(v), integer: true}, true
+	case int64:
+		return orderNumber{i: v, integer: true}, true
+	case uint32:
+		return orderNumber{i: int64(v), integer: true}, true
+	case uint64:
+		if v <= math.MaxInt64 {
+			return orderNumber{i: int64(v), integer: true}, true
+		}
+		return orderNumber{f: float64(v)}, true
+	case float32:
+		return orderNumber{f: float64(v)}, true
+	case float64:
+		if v == math.Trunc(v) && math.Abs(v) < 1<<53 {
+			return orderNumber{i: int64(v), integer: true}, true
+		}
+		return orderNumber{f: v}, true
+	case json.Number:
+		if i, err := v.Int64(); err == nil {
+			return orderNumber{i: i, integer: true}, true
+		}
+		if f, err := v.Float64(); err == nil {
+			return orderNumber{f: f}, true
+		}
+	}
+	return orderNumber{}, false
+}
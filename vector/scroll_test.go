@@ -0,0 +1,191 @@
+// Copyright (c) 2025 Beijing Volcano Engine Technology Co., Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package vector_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/volcengine/vikingdb-go-sdk/vector"
+	"github.com/volcengine/vikingdb-go-sdk/vector/fakes"
+	"github.com/volcengine/vikingdb-go-sdk/vector/filter"
+	"github.com/volcengine/vikingdb-go-sdk/vector/model"
+	"github.com/volcengine/vikingdb-go-sdk/vector/vectortest"
+)
+
+var testIndex = model.IndexLocator{
+	CollectionLocator: model.CollectionLocator{CollectionName: "docs"},
+	IndexName:         "idx",
+}
+
+func TestScrollerVisitsEveryDocument(t *testing.T) {
+	srv := vectortest.NewServer()
+	defer srv.Close()
+	for _, id := range []int{7, 3, 12, 0, 9, 1, 4, 11, 2, 10, 5, 8, 6} {
+		group := "odd"
+		if id%2 == 0 {
+			group = "even"
+		}
+		if err := srv.Insert("docs", model.MapStr{"id": id, "group": group, "rank": id % 3}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	client, err := srv.NewClient()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cases := []struct {
+		name     string
+		options  vector.ScrollOptions
+		wantIDs  []int
+		wantCode model.ErrorCode
+	}{
+		{name: "whole index", options: vector.ScrollOptions{Field: "id", PageSize: 4}, wantIDs: seq(0, 13, 1)},
+		{name: "page size divides total", options: vector.ScrollOptions{Field: "id", PageSize: 13}, wantIDs: seq(0, 13, 1)},
+		{name: "filtered", options: vector.ScrollOptions{Field: "id", PageSize: 2, Filter: filter.Must("group", "even")}, wantIDs: seq(0, 13, 2)},
+		{name: "non-unique field", options: vector.ScrollOptions{Field: "rank", PageSize: 5}, wantCode: model.ErrCodeInvalidParameter},
+		{name: "defaults to the primary key", options: vector.ScrollOptions{PageSize: 5}, wantIDs: seq(0, 13, 1)},
+		{name: "defaults to the primary key with a filter", options: vector.ScrollOptions{PageSize: 3, Filter: filter.Must("group", "odd")}, wantIDs: seq(1, 13, 2)},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			var got []int
+			err := vector.NewScroller(client.Index(testIndex), tc.options).ForEach(context.Background(), func(item model.SearchItemResult) error {
+				id, err := toInt(item.ID)
+				got = append(got, id)
+				return err
+			})
+			if tc.wantCode != "" {
+				var sdkErr *model.Error
+				if !errors.As(err, &sdkErr) || sdkErr.Code != tc.wantCode {
+					t.Fatalf("err = %v, want code %s", err, tc.wantCode)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("scroll: %v", err)
+			}
+			if fmt.Sprint(got) != fmt.Sprint(tc.wantIDs) {
+				t.Fatalf("ids = %v, want %v", got, tc.wantIDs)
+			}
+		})
+	}
+}
+
+func TestScrollerComparesTypedValues(t *testing.T) {
+	cases := []struct {
+		name       string
+		first      interface{}
+		second     interface{}
+		wantUnique bool
+	}{
+		{name: "number and numeric string", first: json.Number("1"), second: "1", wantUnique: true},
+		{name: "large distinct integers", first: json.Number("1152921504606846976"), second: json.Number("1152921504606846977"), wantUnique: true},
+		{name: "same number in two representations", first: float64(1), second: json.Number("1"), wantUnique: false},
+		{name: "equal strings", first: "a", second: "a", wantUnique: false},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			pages := [][]interface{}{{tc.first}, {tc.second}, {}}
+			index := &fakes.IndexClient{
+				SearchByScalarFunc: func(ctx context.Context, req model.SearchByScalarRequest, opts ...vector.RequestOption) (*model.SearchResponse, error) {
+					values := pages[0]
+					pages = pages[1:]
+					result := &model.SearchResult{}
+					for i, v := range values {
+						result.Data = append(result.Data, model.SearchItemResult{ID: i, Fields: model.MapStr{"key": v}})
+					}
+					return &model.SearchResponse{Result: result}, nil
+				},
+			}
+			n := 0
+			err := vector.NewScroller(index, vector.ScrollOptions{Field: "key", PageSize: 1}).ForEach(context.Background(), func(model.SearchItemResult) error {
+				n++
+				return nil
+			})
+			if tc.wantUnique && (err != nil || n != 2) {
+				t.Fatalf("n = %d, err = %v", n, err)
+			}
+			if !tc.wantUnique && err == nil {
+				t.Fatal("repeated value was not reported")
+			}
+
+			calls := index.CallsTo("SearchByScalar")
+			if len(calls) < 2 {
+				t.Fatalf("made %d calls", len(calls))
+			}
+			// Later pages resume strictly after the last value and carry no growing exclusion list.
+			req := calls[1].Request.(model.SearchByScalarRequest)
+			if req.Advance != nil {
+				t.Fatalf("advance = %+v, want none", req.Advance)
+			}
+			want := filter.Range("key", filter.Gt(tc.first)).MustBuild()
+			if fmt.Sprint(req.Filter) != fmt.Sprint(want) {
+				t.Fatalf("filter = %v, want %v", req.Filter, want)
+			}
+		})
+	}
+}
+
+func TestScrollerRequiresTheOrderingField(t *testing.T) {
+	index := &fakes.IndexClient{
+		SearchByScalarFunc: func(ctx context.Context, req model.SearchByScalarRequest, opts ...vector.RequestOption) (*model.SearchResponse, error) {
+			return &model.SearchResponse{Result: &model.SearchResult{Data: []model.SearchItemResult{
+				{ID: 1, Fields: model.MapStr{"key": 1}},
+				{ID: 2, Fields: model.MapStr{"other": 2}},
+			}}}, nil
+		},
+	}
+	cases := []struct {
+		name    string
+		options vector.ScrollOptions
+		wantN   int
+	}{
+		// The fake cannot look up the primary key, so there is nothing to default to.
+		{name: "no field", options: vector.ScrollOptions{}, wantN: 0},
+		// Documents without the chosen field stop the scroll instead of falling back to their ID.
+		{name: "field missing from a document", options: vector.ScrollOptions{Field: "key"}, wantN: 0},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			n := 0
+			err := vector.NewScroller(index, tc.options).ForEach(context.Background(), func(model.SearchItemResult) error {
+				n++
+				return nil
+			})
+			var sdkErr *model.Error
+			if !errors.As(err, &sdkErr) || sdkErr.Code != model.ErrCodeInvalidParameter {
+				t.Fatalf("err = %v, want an InvalidParameter error", err)
+			}
+			if n != tc.wantN {
+				t.Fatalf("visited %d documents, want %d", n, tc.wantN)
+			}
+		})
+	}
+}
+
+func seq(from, to, step int) []int {
+	var out []int
+	for i := from; i < to; i += step {
+		out = append(out, i)
+	}
+	return out
+}
+
+func toInt(value interface{}) (int, error) {
+	switch v := value.(type) {
+	case json.Number:
+		n, err := v.Int64()
+		return int(n), err
+	case float64:
+		return int(v), nil
+	case int:
+		return v, nil
+	}
+	return 0, fmt.Errorf("unexpected id %T %v", value, value)
+}
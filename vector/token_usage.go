@@ -0,0 +1,89 @@
+// Copyright (c) 2025 Beijing Volcano Engine Technology Co., Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package vector
+
+import (
+	"encoding/json"
+
+	"github.com/volcengine/vikingdb-go-sdk/vector/model"
+)
+
+// mergeTokenUsage adds the numeric leaves of src (typically a decoded token_usage object) into dst,
+// preserving the nesting of per-model usage maps. Integers stay int64 and other numbers become float64.
+func mergeTokenUsage(dst model.MapStr, src interface{}) model.MapStr {
+	if dst == nil {
+		dst = model.MapStr{}
+	}
+	var fields map[string]interface{}
+	switch v := src.(type) {
+	case model.MapStr:
+		fields = v
+	case map[string]interface{}:
+		fields = v
+	default:
+		return dst
+	}
+
+	for key, value := range fields {
+		switch value.(type) {
+		case model.MapStr, map[string]interface{}:
+			nested, _ := dst[key].(model.MapStr)
+			dst[key] = mergeTokenUsage(nested, value)
+			continue
+		}
+		dst[key] = addUsageNumbers(dst[key], value)
+	}
+	return dst
+}
+
+func addUsageNumbers(current, delta interface{}) interface{} {
+	ci, cInt := usageInt(current)
+	di, dInt := usageInt(delta)
+	if (current == nil || cInt) && dInt {
+		return ci + di
+	}
+	cf, cOK := usageFloat(current)
+	df, dOK := usageFloat(delta)
+	if !dOK {
+		if current == nil {
+			return delta
+		}
+		return current
+	}
+	if !cOK {
+		cf = 0
+	}
+	return cf + df
+}
+
+func usageInt(v interface{}) (int64, bool) {
+	switch n := v.(type) {
+	case json.Number:
+		i, err := n.Int64()
+		return i, err == nil
+	case int:
+		return int64(n), true
+	case int32:
+		return int64(n), true
+	case int64:
+		return n, true
+	}
+	return 0, false
+}
+
+func usageFloat(v interface{}) (float64, bool) {
+	switch n := v.(type) {
+	case json.Number:
+		f, err := n.Float64()
+		return f, err == nil
+	case float64:
+		return n, true
+	case float32:
+		return float64(n), true
+	}
+	if i, ok := usageInt(v); ok {
+		return float64(i), true
+	}
+	return 0, false
+}
@@ -0,0 +1,369 @@
+// Copyright (c) 2025 Beijing Volcano Engine Technology Co., Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package vector
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+
+	"github.com/volcengine/vikingdb-go-sdk/vector/filter"
+	"github.com/volcengine/vikingdb-go-sdk/vector/model"
+	"github.com/volcengine/vikingdb-go-sdk/vector/utils"
+)
+
+// TransferFormat selects the file format used by Export and Import.
+type TransferFormat string
+
+const (
+	// FormatJSONL writes one model.IndexDataItem JSON object per line.
+	FormatJSONL TransferFormat = "jsonl"
+	// FormatColumnar writes blocks of column-major JSON scalars and little-endian float32 vectors.
+	FormatColumnar TransferFormat = "columnar"
+)
+
+const defaultTransferBatchSize = 100
+
+// TransferOption customises Export and Import.
+type TransferOption func(*transferOptions)
+
+type transferOptions struct {
+	scrollField    string
+	filter         filter.Filter
+	outputFields   []string
+	partition      string
+	batchSize      int
+	withVectors    bool
+	primaryKey     string
+	vectorField    string
+	bulkConfig     BulkWriterConfig
+	requestOptions []RequestOption
+}
+
+// WithScrollField sets the scalar field Export orders the scroll on, typically the primary key. Required for Export.
+func WithScrollField(field string) TransferOption {
+	return func(o *transferOptions) {
+		o.scrollField = field
+	}
+}
+
+// WithTransferFilter restricts the documents exported.
+func WithTransferFilter(f filter.Filter) TransferOption {
+	return func(o *transferOptions) {
+		o.filter = f
+	}
+}
+
+// WithTransferOutputFields limits the exported fields.
+func WithTransferOutputFields(fields ...string) TransferOption {
+	return func(o *transferOptions) {
+		o.outputFields = fields
+	}
+}
+
+// WithTransferPartition scopes the export to a partition.
+func WithTransferPartition(partition string) TransferOption {
+	return func(o *transferOptions) {
+		o.partition = partition
+	}
+}
+
+// WithTransferBatchSize sets how many documents are read per page and written per columnar block.
+func WithTransferBatchSize(size int) TransferOption {
+	return func(o *transferOptions) {
+		o.batchSize = size
+	}
+}
+
+// WithDenseVectors makes Export fetch and write each document's dense vector.
+func WithDenseVectors() TransferOption {
+	return func(o *transferOptions) {
+		o.withVectors = true
+	}
+}
+
+// WithImportPrimaryKey names the primary key field Import writes each record's ID into.
+// Without it, imported documents receive new IDs.
+func WithImportPrimaryKey(field string) TransferOption {
+	return func(o *transferOptions) {
+		o.primaryKey = field
+	}
+}
+
+// WithImportVectorField names the field Import writes each record's dense vector into.
+// Without it, exported vectors are dropped, which suits collections that vectorize server-side.
+func WithImportVectorField(field string) TransferOption {
+	return func(o *transferOptions) {
+		o.vectorField = field
+	}
+}
+
+// WithImportBulkConfig configures the BulkWriter used by Import.
+func WithImportBulkConfig(config BulkWriterConfig) TransferOption {
+	return func(o *transferOptions) {
+		o.bulkConfig = config
+	}
+}
+
+// WithTransferRequestOptions applies the request options to every call made during the transfer.
+func WithTransferRequestOptions(opts ...RequestOption) TransferOption {
+	return func(o *transferOptions) {
+		o.requestOptions = append(o.requestOptions, opts...)
+	}
+}
+
+func newTransferOptions(opts []TransferOption) *transferOptions {
+	options := &transferOptions{}
+	for _, opt := range opts {
+		opt(options)
+	}
+	if options.batchSize <= 0 {
+		options.batchSize = defaultTransferBatchSize
+	}
+	return options
+}
+
+type recordWriter interface {
+	Write(item *model.IndexDataItem) error
+	Close() error
+}
+
+type recordReader interface {
+	// Read returns io.EOF once every record has been consumed.
+	Read() (*model.IndexDataItem, error)
+}
+
+// ExportSummary reports the outcome of an Export.
+type ExportSummary struct {
+	// Documents is the number of documents written.
+	Documents int
+	// MissingIDs lists documents the scroll visited but the vector fetch no longer found, typically
+	// because they were deleted while the export ran. They are not written.
+	MissingIDs []interface{}
+}
+
+// Export writes every document of index to w, in scroll order, and returns a summary of the export.
+func Export(ctx context.Context, index IndexClient, w io.Writer, format TransferFormat, opts ...TransferOption) (*ExportSummary, error) {
+	options := newTransferOptions(opts)
+	summary := &ExportSummary{}
+	if options.scrollField == "" {
+		return summary, model.NewInvalidParameterError("export requires a scroll field, see WithScrollField")
+	}
+	writer, err := newRecordWriter(w, format, options.batchSize)
+	if err != nil {
+		return summary, err
+	}
+
+	scroller := NewScroller(index, ScrollOptions{
+		Field:          options.scrollField,
+		Filter:         options.filter,
+		OutputFields:   options.outputFields,
+		Partition:      options.partition,
+		PageSize:       options.batchSize,
+		RequestOptions: options.requestOptions,
+	})
+
+	pending := make([]model.SearchItemResult, 0, options.batchSize)
+	flush := func() error {
+		if len(pending) == 0 {
+			return nil
+		}
+		items, missing, err := exportItems(ctx, index, pending, options)
+		if err != nil {
+			return err
+		}
+		summary.MissingIDs = append(summary.MissingIDs, missing...)
+		for _, item := range items {
+			if err := writer.Write(item); err != nil {
+				return model.NewErrorWithCause(model.ErrCodeUnknown, "failed to write export record", err, http.StatusInternalServerError)
+			}
+			summary.Documents++
+		}
+		pending = pending[:0]
+		return nil
+	}
+
+	err = scroller.ForEach(ctx, func(item model.SearchItemResult) error {
+		pending = append(pending, item)
+		if len(pending) >= options.batchSize {
+			return flush()
+		}
+		return nil
+	})
+	if err == nil {
+		err = flush()
+	}
+	if closeErr := writer.Close(); err == nil && closeErr != nil {
+		err = model.NewErrorWithCause(model.ErrCodeUnknown, "failed to finish export", closeErr, http.StatusInternalServerError)
+	}
+	return summary, err
+}
+
+// exportItems turns a page of scroll hits into export records, in the order of hits. With dense vectors
+// requested, the hits are fetched again and the IDs the fetch did not return are reported as missing.
+func exportItems(ctx context.Context, index IndexClient, hits []model.SearchItemResult, options *transferOptions) ([]*model.IndexDataItem, []interface{}, error) {
+	items := make([]*model.IndexDataItem, 0, len(hits))
+	if !options.withVectors {
+		for _, hit := range hits {
+			items = append(items, &model.IndexDataItem{DataItem: model.DataItem{ID: hit.ID, Fields: hit.Fields}})
+		}
+		return items, nil, nil
+	}
+
+	ids := make([]interface{}, 0, len(hits))
+	for _, hit := range hits {
+		ids = append(ids, hit.ID)
+	}
+	resp, err := index.Fetch(ctx, model.FetchDataInIndexRequest{
+		IDs:          ids,
+		Partition:    options.partition,
+		OutputFields: options.outputFields,
+	}, options.requestOptions...)
+	if err != nil {
+		return nil, nil, err
+	}
+	if resp == nil || resp.Result == nil {
+		return nil, nil, model.NewError(model.ErrCodeUnknown, "export fetch returned no result")
+	}
+
+	fetched := make(map[string]*model.IndexDataItem, len(resp.Result.Items))
+	for i := range resp.Result.Items {
+		item := &resp.Result.Items[i]
+		fetched[transferIDKey(item.ID)] = item
+	}
+	var missing []interface{}
+	for _, hit := range hits {
+		item, ok := fetched[transferIDKey(hit.ID)]
+		if !ok {
+			missing = append(missing, hit.ID)
+			continue
+		}
+		items = append(items, item)
+	}
+	return items, missing, nil
+}
+
+// transferIDKey maps a document ID to a map key that keeps numeric and string IDs apart while treating
+// the different decoded representations of the same number alike.
+func transferIDKey(id interface{}) string {
+	if n, ok := asOrderNumber(id); ok {
+		if n.integer {
+			return "n:" + strconv.FormatInt(n.i, 10)
+		}
+		return "n:" + strconv.FormatFloat(n.f, 'g', -1, 64)
+	}
+	if s, ok := id.(string); ok {
+		return "s:" + s
+	}
+	return fmt.Sprintf("%T:%v", id, id)
+}
+
+// Import upserts every record read from r into collection through a BulkWriter and returns its summary.
+func Import(ctx context.Context, collection CollectionClient, r io.Reader, format TransferFormat, opts ...TransferOption) (*BulkSummary, error) {
+	options := newTransferOptions(opts)
+	reader, err := newRecordReader(r, format)
+	if err != nil {
+		return nil, err
+	}
+
+	bulk := NewBulkWriter(collection, options.bulkConfig)
+	for {
+		item, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err == nil {
+			err = bulk.Add(ctx, importDocument(item, options))
+		}
+		if err != nil {
+			summary, _ := bulk.Close(ctx)
+			return summary, err
+		}
+	}
+	return bulk.Close(ctx)
+}
+
+func importDocument(item *model.IndexDataItem, options *transferOptions) model.MapStr {
+	doc := make(model.MapStr, len(item.Fields)+2)
+	for k, v := range item.Fields {
+		doc[k] = v
+	}
+	if options.primaryKey != "" && item.ID != nil {
+		doc[options.primaryKey] = item.ID
+	}
+	if options.vectorField != "" && len(item.DenseVector) > 0 {
+		doc[options.vectorField] = item.DenseVector
+	}
+	return doc
+}
+
+func newRecordWriter(w io.Writer, format TransferFormat, batchSize int) (recordWriter, error) {
+	switch format {
+	case FormatJSONL, "":
+		return &jsonlWriter{w: bufio.NewWriter(w)}, nil
+	case FormatColumnar:
+		return newColumnarWriter(w, batchSize)
+	}
+	return nil, model.NewInvalidParameterError(fmt.Sprintf("unsupported transfer format %q", format))
+}
+
+func newRecordReader(r io.Reader, format TransferFormat) (recordReader, error) {
+	switch format {
+	case FormatJSONL, "":
+		return &jsonlReader{r: bufio.NewReader(r)}, nil
+	case FormatColumnar:
+		return newColumnarReader(r)
+	}
+	return nil, model.NewInvalidParameterError(fmt.Sprintf("unsupported transfer format %q", format))
+}
+
+type jsonlWriter struct {
+	w *bufio.Writer
+}
+
+func (j *jsonlWriter) Write(item *model.IndexDataItem) error {
+	line, err := utils.SerializeToJSON(item)
+	if err != nil {
+		return err
+	}
+	if _, err := j.w.Write(line); err != nil {
+		return err
+	}
+	return j.w.WriteByte('\n')
+}
+
+func (j *jsonlWriter) Close() error {
+	return j.w.Flush()
+}
+
+type jsonlReader struct {
+	r    *bufio.Reader
+	line int
+}
+
+func (j *jsonlReader) Read() (*model.IndexDataItem, error) {
+	for {
+		raw, err := j.r.ReadBytes('\n')
+		if err != nil && err != io.EOF {
+			return nil, model.NewErrorWithCause(model.ErrCodeUnknown, "failed to read import data", err, http.StatusInternalServerError)
+		}
+		j.line++
+		raw = bytes.TrimSpace(raw)
+		if len(raw) == 0 {
+			if err == io.EOF {
+				return nil, io.EOF
+			}
+			continue
+		}
+		item := &model.IndexDataItem{}
+		if parseErr := utils.ParseJSONUseNumber(raw, item); parseErr != nil {
+			return nil, model.NewErrorWithCause(model.ErrCodeInvalidParameter, fmt.Sprintf("invalid JSONL record on line %d", j.line), parseErr, http.StatusBadRequest)
+		}
+		return item, nil
+	}
+}
@@ -0,0 +1,301 @@
+// Copyright (c) 2025 Beijing Volcano Engine Technology Co., Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package vector
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"sort"
+
+	"github.com/volcengine/vikingdb-go-sdk/vector/model"
+	"github.com/volcengine/vikingdb-go-sdk/vector/utils"
+)
+
+// The columnar format is a sequence of blocks following an 8-byte magic header:
+//
+//	uvarint rows            (0 terminates the stream)
+//	uvarint len, JSON       array of IDs
+//	uvarint len, JSON       object mapping each field name to its column of values (null when absent)
+//	uvarint dim             dense vector dimension, 0 when the block has no vectors
+//	[rows]byte              1 when the row carries a vector (only when dim > 0)
+//	[rows*dim]float32       little-endian vector data, zero for rows without one (only when dim > 0)
+//
+// Scalars stay JSON so json.Number precision survives a round trip, while vectors are stored raw.
+var columnarMagic = []byte("VKDBCOL1")
+
+// Limits on the sizes a columnar block may declare. They are checked before anything is allocated so a
+// corrupt or hostile file fails with an error instead of exhausting memory.
+const (
+	maxColumnarBlockRows = 1 << 20
+	maxColumnarJSONBytes = 256 << 20
+	maxColumnarDim       = 1 << 16
+)
+
+type columnarWriter struct {
+	w         *bufio.Writer
+	batchSize int
+	rows      []*model.IndexDataItem
+}
+
+func newColumnarWriter(w io.Writer, batchSize int) (*columnarWriter, error) {
+	buffered := bufio.NewWriter(w)
+	if _, err := buffered.Write(columnarMagic); err != nil {
+		return nil, model.NewErrorWithCause(model.ErrCodeUnknown, "failed to write columnar header", err, http.StatusInternalServerError)
+	}
+	if batchSize > maxColumnarBlockRows {
+		batchSize = maxColumnarBlockRows
+	}
+	return &columnarWriter{w: buffered, batchSize: batchSize}, nil
+}
+
+func (c *columnarWriter) Write(item *model.IndexDataItem) error {
+	c.rows = append(c.rows, item)
+	if len(c.rows) >= c.batchSize {
+		return c.flushBlock()
+	}
+	return nil
+}
+
+func (c *columnarWriter) Close() error {
+	if err := c.flushBlock(); err != nil {
+		return err
+	}
+	if err := c.writeUvarint(0); err != nil {
+		return err
+	}
+	return c.w.Flush()
+}
+
+func (c *columnarWriter) flushBlock() error {
+	if len(c.rows) == 0 {
+		return nil
+	}
+	rows := c.rows
+	c.rows = nil
+
+	ids := make([]interface{}, len(rows))
+	names := map[string]struct{}{}
+	dim := 0
+	for i, row := range rows {
+		ids[i] = row.ID
+		for name := range row.Fields {
+			names[name] = struct{}{}
+		}
+		if n := len(row.DenseVector); n > 0 {
+			if dim != 0 && dim != n {
+				return fmt.Errorf("columnar block mixes vector dimensions %d and %d", dim, n)
+			}
+			dim = n
+		}
+	}
+	if dim > maxColumnarDim {
+		return fmt.Errorf("vector dimension %d exceeds the columnar limit of %d", dim, maxColumnarDim)
+	}
+	ordered := make([]string, 0, len(names))
+	for name := range names {
+		ordered = append(ordered, name)
+	}
+	sort.Strings(ordered)
+	columns := make(map[string][]interface{}, len(ordered))
+	for _, name := range ordered {
+		column := make([]interface{}, len(rows))
+		for i, row := range rows {
+			column[i] = row.Fields[name]
+		}
+		columns[name] = column
+	}
+
+	if err := c.writeUvarint(uint64(len(rows))); err != nil {
+		return err
+	}
+	if err := c.writeJSON(ids); err != nil {
+		return err
+	}
+	if err := c.writeJSON(columns); err != nil {
+		return err
+	}
+	if err := c.writeUvarint(uint64(dim)); err != nil {
+		return err
+	}
+	if dim == 0 {
+		return nil
+	}
+	presence := make([]byte, len(rows))
+	for i, row := range rows {
+		if len(row.DenseVector) > 0 {
+			presence[i] = 1
+		}
+	}
+	if _, err := c.w.Write(presence); err != nil {
+		return err
+	}
+	buf := make([]byte, 4)
+	for _, row := range rows {
+		for j := 0; j < dim; j++ {
+			var v float32
+			if len(row.DenseVector) > 0 {
+				v = row.DenseVector[j]
+			}
+			binary.LittleEndian.PutUint32(buf, math.Float32bits(v))
+			if _, err := c.w.Write(buf); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (c *columnarWriter) writeUvarint(v uint64) error {
+	buf := make([]byte, binary.MaxVarintLen64)
+	n := binary.PutUvarint(buf, v)
+	_, err := c.w.Write(buf[:n])
+	return err
+}
+
+func (c *columnarWriter) writeJSON(v interface{}) error {
+	encoded, err := utils.SerializeToJSON(v)
+	if err != nil {
+		return err
+	}
+	if err := c.writeUvarint(uint64(len(encoded))); err != nil {
+		return err
+	}
+	_, err = c.w.Write(encoded)
+	return err
+}
+
+type columnarReader struct {
+	r    *bufio.Reader
+	rows []*model.IndexDataItem
+	pos  int
+	done bool
+}
+
+func newColumnarReader(r io.Reader) (*columnarReader, error) {
+	buffered := bufio.NewReader(r)
+	header := make([]byte, len(columnarMagic))
+	if _, err := io.ReadFull(buffered, header); err != nil || string(header) != string(columnarMagic) {
+		return nil, model.NewInvalidParameterError("input is not in the columnar transfer format")
+	}
+	return &columnarReader{r: buffered}, nil
+}
+
+func (c *columnarReader) Read() (*model.IndexDataItem, error) {
+	for c.pos >= len(c.rows) {
+		if c.done {
+			return nil, io.EOF
+		}
+		if err := c.readBlock(); err != nil {
+			return nil, model.NewErrorWithCause(model.ErrCodeInvalidParameter, "corrupt columnar block", err, http.StatusBadRequest)
+		}
+	}
+	row := c.rows[c.pos]
+	c.pos++
+	return row, nil
+}
+
+func (c *columnarReader) readBlock() error {
+	count, err := readUvarint(c.r)
+	if err != nil {
+		return err
+	}
+	if count == 0 {
+		c.done = true
+		c.rows, c.pos = nil, 0
+		return nil
+	}
+	if count > maxColumnarBlockRows {
+		return fmt.Errorf("block declares %d rows, more than the limit of %d", count, maxColumnarBlockRows)
+	}
+
+	var ids []interface{}
+	if err := c.readJSON(&ids); err != nil {
+		return err
+	}
+	var columns map[string][]interface{}
+	if err := c.readJSON(&columns); err != nil {
+		return err
+	}
+	if uint64(len(ids)) != count {
+		return fmt.Errorf("block declares %d rows but carries %d ids", count, len(ids))
+	}
+
+	rows := make([]*model.IndexDataItem, count)
+	for i := range rows {
+		rows[i] = &model.IndexDataItem{DataItem: model.DataItem{ID: ids[i], Fields: model.MapStr{}}}
+	}
+	for name, column := range columns {
+		if uint64(len(column)) != count {
+			return fmt.Errorf("column %q has %d values, want %d", name, len(column), count)
+		}
+		for i, value := range column {
+			if value != nil {
+				rows[i].Fields[name] = value
+			}
+		}
+	}
+
+	dim, err := readUvarint(c.r)
+	if err != nil {
+		return err
+	}
+	if dim > maxColumnarDim {
+		return fmt.Errorf("block declares vector dimension %d, more than the limit of %d", dim, maxColumnarDim)
+	}
+	if dim > 0 {
+		presence := make([]byte, count)
+		if _, err := io.ReadFull(c.r, presence); err != nil {
+			return err
+		}
+		buf := make([]byte, 4*dim)
+		for i, row := range rows {
+			if _, err := io.ReadFull(c.r, buf); err != nil {
+				return err
+			}
+			if presence[i] == 0 {
+				continue
+			}
+			row.DenseDim = int(dim)
+			row.DenseVector = make([]float32, dim)
+			for j := range row.DenseVector {
+				row.DenseVector[j] = math.Float32frombits(binary.LittleEndian.Uint32(buf[4*j:]))
+			}
+		}
+	}
+	c.rows, c.pos = rows, 0
+	return nil
+}
+
+func (c *columnarReader) readJSON(target interface{}) error {
+	size, err := readUvarint(c.r)
+	if err != nil {
+		return err
+	}
+	if size > maxColumnarJSONBytes {
+		return fmt.Errorf("block declares a %d byte JSON section, more than the limit of %d", size, maxColumnarJSONBytes)
+	}
+	// Grow the buffer as data arrives rather than trusting the declared size up front.
+	var buf bytes.Buffer
+	if n, err := io.CopyN(&buf, c.r, int64(size)); err != nil {
+		if err == io.EOF {
+			return fmt.Errorf("JSON section truncated after %d of %d bytes", n, size)
+		}
+		return err
+	}
+	return utils.ParseJSONUseNumber(buf.Bytes(), target)
+}
+
+// readUvarint reads a uvarint, reporting a stream that ends inside the block as truncated.
+func readUvarint(r io.ByteReader) (uint64, error) {
+	v, err := binary.ReadUvarint(r)
+	if err == io.EOF {
+		return 0, io.ErrUnexpectedEOF
+	}
+	return v, err
+}
@@ -0,0 +1,192 @@
+// Copyright (c) 2025 Beijing Volcano Engine Technology Co., Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package vector_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"testing"
+
+	"github.com/volcengine/vikingdb-go-sdk/vector"
+	"github.com/volcengine/vikingdb-go-sdk/vector/fakes"
+	"github.com/volcengine/vikingdb-go-sdk/vector/model"
+	"github.com/volcengine/vikingdb-go-sdk/vector/utils"
+	"github.com/volcengine/vikingdb-go-sdk/vector/vectortest"
+)
+
+func TestTransferRoundTrip(t *testing.T) {
+	for _, format := range []vector.TransferFormat{vector.FormatJSONL, vector.FormatColumnar} {
+		t.Run(string(format), func(t *testing.T) {
+			srv := vectortest.NewServer()
+			defer srv.Close()
+			source := []model.MapStr{
+				// 2^53+1 is not representable as a float64 and must survive as a json.Number.
+				{"id": 1, "big": json.Number("9007199254740993"), "title": "a", "vector": []float64{0.5, 0.25}},
+				{"id": 2, "big": json.Number("-3"), "tags": []interface{}{"x", "y"}, "vector": []float64{1, 0}},
+				{"id": 3, "ratio": json.Number("0.125"), "vector": []float64{0, 1}},
+				{"id": 4, "title": "d", "vector": []float64{-0.5, 0.75}},
+				{"id": 5, "title": "e", "vector": []float64{0.25, 0.25}},
+			}
+			if err := srv.Insert("src", source...); err != nil {
+				t.Fatal(err)
+			}
+			client, err := srv.NewClient()
+			if err != nil {
+				t.Fatal(err)
+			}
+			index := client.Index(model.IndexLocator{CollectionLocator: model.CollectionLocator{CollectionName: "src"}, IndexName: "idx"})
+
+			var buf bytes.Buffer
+			summary, err := vector.Export(context.Background(), index, &buf, format,
+				vector.WithScrollField("id"), vector.WithDenseVectors(), vector.WithTransferBatchSize(2))
+			if err != nil {
+				t.Fatalf("Export: %v", err)
+			}
+			if summary.Documents != len(source) || len(summary.MissingIDs) != 0 {
+				t.Fatalf("export summary = %+v", summary)
+			}
+
+			dst := client.Collection(model.CollectionLocator{CollectionName: "dst"})
+			imported, err := vector.Import(context.Background(), dst, &buf, format,
+				vector.WithImportPrimaryKey("id"), vector.WithImportVectorField("vector"))
+			if err != nil {
+				t.Fatalf("Import: %v", err)
+			}
+			if imported.Items != len(source) {
+				t.Fatalf("import summary = %+v", imported)
+			}
+
+			if got, want := canonicalDocs(t, srv.Documents("dst")), canonicalDocs(t, source); got != want {
+				t.Fatalf("round trip changed the documents\n got: %s\nwant: %s", got, want)
+			}
+		})
+	}
+}
+
+func TestExportKeepsScrollOrderAndReportsMissing(t *testing.T) {
+	index := &fakes.IndexClient{
+		SearchByScalarFunc: func(ctx context.Context, req model.SearchByScalarRequest, opts ...vector.RequestOption) (*model.SearchResponse, error) {
+			if req.Filter != nil {
+				return &model.SearchResponse{Result: &model.SearchResult{}}, nil
+			}
+			return &model.SearchResponse{Result: &model.SearchResult{Data: []model.SearchItemResult{
+				{ID: json.Number("1"), Fields: model.MapStr{"id": json.Number("1")}},
+				{ID: json.Number("2"), Fields: model.MapStr{"id": json.Number("2")}},
+				{ID: json.Number("3"), Fields: model.MapStr{"id": json.Number("3")}},
+				{ID: "3", Fields: model.MapStr{"id": "3"}},
+			}}}, nil
+		},
+		FetchFunc: func(ctx context.Context, req model.FetchDataInIndexRequest, opts ...vector.RequestOption) (*model.FetchDataInIndexResponse, error) {
+			// Out of order, with document 2 deleted since the scroll and the string ID "3" unknown.
+			return &model.FetchDataInIndexResponse{Result: &model.FetchDataInIndexResult{
+				Items: []model.IndexDataItem{
+					{DataItem: model.DataItem{ID: float64(3)}, DenseVector: []float32{3}},
+					{DataItem: model.DataItem{ID: json.Number("1")}, DenseVector: []float32{1}},
+				},
+				NotFoundIDs: []interface{}{json.Number("2")},
+			}}, nil
+		},
+	}
+
+	var buf bytes.Buffer
+	summary, err := vector.Export(context.Background(), index, &buf, vector.FormatJSONL,
+		vector.WithScrollField("id"), vector.WithDenseVectors(), vector.WithTransferBatchSize(10))
+	if err != nil {
+		t.Fatalf("Export: %v", err)
+	}
+	if summary.Documents != 2 || fmt.Sprint(summary.MissingIDs) != "[2 3]" {
+		t.Fatalf("summary = %+v", summary)
+	}
+	lines := bytes.Split(bytes.TrimSpace(buf.Bytes()), []byte("\n"))
+	if len(lines) != 2 || !bytes.Contains(lines[0], []byte(`"id":1`)) || !bytes.Contains(lines[1], []byte(`"id":3`)) {
+		t.Fatalf("records not in scroll order:\n%s", buf.String())
+	}
+
+	index.FetchFunc = func(ctx context.Context, req model.FetchDataInIndexRequest, opts ...vector.RequestOption) (*model.FetchDataInIndexResponse, error) {
+		return &model.FetchDataInIndexResponse{}, nil
+	}
+	if _, err := vector.Export(context.Background(), index, &bytes.Buffer{}, vector.FormatJSONL,
+		vector.WithScrollField("id"), vector.WithDenseVectors()); err == nil {
+		t.Fatal("a fetch without result was silently accepted")
+	}
+}
+
+func TestImportRejectsCorruptColumnarInput(t *testing.T) {
+	uvarint := func(v uint64) []byte {
+		buf := make([]byte, binary.MaxVarintLen64)
+		return buf[:binary.PutUvarint(buf, v)]
+	}
+	section := func(v interface{}) []byte {
+		encoded, err := utils.SerializeToJSON(v)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return append(uvarint(uint64(len(encoded))), encoded...)
+	}
+	join := func(parts ...[]byte) []byte {
+		return bytes.Join(append([][]byte{[]byte("VKDBCOL1")}, parts...), nil)
+	}
+	ids := section([]int{1})
+	columns := section(map[string][]interface{}{"f": {"x"}})
+
+	cases := []struct {
+		name  string
+		input []byte
+	}{
+		{name: "missing terminator", input: join()},
+		{name: "truncated row count", input: join([]byte{0x80})},
+		{name: "oversized row count", input: join(uvarint(1<<62), ids, columns, uvarint(0), uvarint(0))},
+		{name: "oversized JSON section", input: join(uvarint(1), uvarint(1<<62))},
+		{name: "truncated JSON section", input: join(uvarint(1), uvarint(1<<20), []byte(`[1]`))},
+		{name: "row count mismatch", input: join(uvarint(2), ids, columns, uvarint(0), uvarint(0))},
+		{name: "oversized dimension", input: join(uvarint(1), ids, columns, uvarint(1<<40))},
+		{name: "truncated vectors", input: join(uvarint(1), ids, columns, uvarint(4), []byte{1, 0, 0})},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			collection := &fakes.CollectionClient{}
+			_, err := vector.Import(context.Background(), collection, bytes.NewReader(tc.input), vector.FormatColumnar)
+			var sdkErr *model.Error
+			if !errors.As(err, &sdkErr) || sdkErr.Code != model.ErrCodeInvalidParameter {
+				t.Fatalf("err = %v, want an invalid parameter error", err)
+			}
+			if calls := collection.CallsTo("Upsert"); len(calls) != 0 {
+				t.Fatalf("corrupt input was upserted: %d calls", len(calls))
+			}
+		})
+	}
+
+	valid := join(uvarint(1), ids, columns, uvarint(2), []byte{1}, make([]byte, 8), uvarint(0))
+	summary, err := vector.Import(context.Background(), &fakes.CollectionClient{}, bytes.NewReader(valid), vector.FormatColumnar)
+	if err != nil || summary.Items != 1 {
+		t.Fatalf("valid input: summary = %+v, err = %v", summary, err)
+	}
+}
+
+// canonicalDocs renders documents as sorted JSON so stored Go values and decoded JSON values compare equal.
+func canonicalDocs(t *testing.T, docs []model.MapStr) string {
+	var out []string
+	for _, doc := range docs {
+		encoded, err := utils.SerializeToJSON(doc)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var normalized interface{}
+		if err := utils.ParseJSONUseNumber(encoded, &normalized); err != nil {
+			t.Fatal(err)
+		}
+		again, err := utils.SerializeToJSON(normalized)
+		if err != nil {
+			t.Fatal(err)
+		}
+		out = append(out, string(again))
+	}
+	sort.Strings(out)
+	return fmt.Sprint(out)
+}
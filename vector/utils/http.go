@@ -7,6 +7,9 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/volcengine/vikingdb-go-sdk/vector/model"
 )
@@ -20,6 +23,25 @@ func DoHTTPRequest(client *http.Client, req *http.Request) (*http.Response, erro
 	return resp, nil
 }
 
+// ParseRetryAfter interprets a Retry-After header given either in seconds or as an HTTP date.
+// It returns zero when the header is absent or malformed.
+func ParseRetryAfter(value string, now time.Time) time.Duration {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return 0
+	}
+	if seconds, err := strconv.Atoi(value); err == nil {
+		if seconds <= 0 {
+			return 0
+		}
+		return time.Duration(seconds) * time.Second
+	}
+	if at, err := http.ParseTime(value); err == nil && at.After(now) {
+		return at.Sub(now)
+	}
+	return 0
+}
+
 // ParseResponse reads the HTTP response body, decoding JSON into result when provided.
 func ParseResponse(resp *http.Response, result interface{}) error {
 	body, err := io.ReadAll(resp.Body)
@@ -28,15 +50,26 @@ func ParseResponse(resp *http.Response, result interface{}) error {
 	}
 
 	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		// Memory endpoints report numeric codes, VikingDB endpoints string ones.
 		var errResp struct {
-			Code      string `json:"code"`
-			Message   string `json:"message"`
-			RequestID string `json:"request_id"`
+			Code      interface{} `json:"code"`
+			Message   string      `json:"message"`
+			RequestID string      `json:"request_id"`
+		}
+		var sdkErr *model.Error
+		if parseErr := ParseJSONUseNumber(body, &errResp); parseErr == nil && (errResp.Code != nil || errResp.Message != "") {
+			code := ""
+			if errResp.Code != nil {
+				code = fmt.Sprint(errResp.Code)
+			}
+			sdkErr = model.NewErrorWithRequestID(model.ErrorCode(code), errResp.Message, errResp.RequestID, resp.StatusCode)
+		} else {
+			sdkErr = model.NewErrorWithCause(model.ErrCodeUnknown, fmt.Sprintf("unexpected %d response: %s", resp.StatusCode, string(body)), err, resp.StatusCode)
 		}
-		if parseErr := ParseJSONUseNumber(body, &errResp); parseErr == nil && (errResp.Code != "" || errResp.Message != "") {
-			return model.NewErrorWithRequestID(model.ErrorCode(errResp.Code), errResp.Message, errResp.RequestID, resp.StatusCode)
+		if resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode == http.StatusServiceUnavailable {
+			sdkErr.RetryAfter = ParseRetryAfter(resp.Header.Get("Retry-After"), time.Now())
 		}
-		return model.NewErrorWithCause(model.ErrCodeUnknown, fmt.Sprintf("unexpected %d response: %s", resp.StatusCode, string(body)), err, resp.StatusCode)
+		return sdkErr
 	}
 
 	if result == nil || len(body) == 0 {
@@ -4,6 +4,7 @@
 package utils
 
 import (
+	"context"
 	"math/rand"
 	"time"
 
@@ -16,44 +17,152 @@ const (
 	backoffMultiplier     = 2.0
 )
 
-// Retry executes fn with exponential backoff. Retries stop when fn returns nil, the max retry count is reached,
-// or shouldRetry returns false for the latest error.
-func Retry(maxRetries int, fn func() error, shouldRetry func(error) bool) error {
+// JitterStrategy selects how randomness is applied to each backoff delay.
+type JitterStrategy int
+
+const (
+	// JitterAdditive sleeps between delay and twice the delay. It is the historical SDK behaviour.
+	JitterAdditive JitterStrategy = iota
+	// JitterNone sleeps exactly the computed delay.
+	JitterNone
+	// JitterFull sleeps a random duration between zero and the delay.
+	JitterFull
+	// JitterEqual sleeps half the delay plus a random duration up to the other half.
+	JitterEqual
+)
+
+// RetryPolicy controls the backoff between attempts and which errors are retried.
+// Zero fields fall back to the defaults of DefaultRetryPolicy.
+type RetryPolicy struct {
+	InitialBackoff time.Duration
+	MaxBackoff     time.Duration
+	Multiplier     float64
+	Jitter         JitterStrategy
+	// IgnoreRetryAfter disables waiting for the Retry-After duration carried by 429 and 503 replies.
+	// When honoured, a Retry-After longer than MaxBackoff is capped at MaxBackoff.
+	IgnoreRetryAfter bool
+	// ShouldRetry classifies errors; model.IsRetryableError is used when nil.
+	ShouldRetry func(error) bool
+}
+
+// DefaultRetryPolicy returns the policy used when none is configured.
+func DefaultRetryPolicy() RetryPolicy {
+	return RetryPolicy{
+		InitialBackoff: defaultInitialBackoff,
+		MaxBackoff:     defaultMaxBackoff,
+		Multiplier:     backoffMultiplier,
+		Jitter:         JitterAdditive,
+		ShouldRetry:    model.IsRetryableError,
+	}
+}
+
+func (p RetryPolicy) withDefaults() RetryPolicy {
+	defaults := DefaultRetryPolicy()
+	if p.InitialBackoff <= 0 {
+		p.InitialBackoff = defaults.InitialBackoff
+	}
+	if p.MaxBackoff <= 0 {
+		p.MaxBackoff = defaults.MaxBackoff
+	}
+	if p.Multiplier < 1 {
+		p.Multiplier = defaults.Multiplier
+	}
+	if p.ShouldRetry == nil {
+		p.ShouldRetry = defaults.ShouldRetry
+	}
+	return p
+}
+
+// Backoff returns the wait before the given retry (1 for the first retry), jitter included.
+func (p RetryPolicy) Backoff(retry int) time.Duration {
+	p = p.withDefaults()
+	delay := p.InitialBackoff
+	for i := 1; i < retry && delay < p.MaxBackoff; i++ {
+		delay = time.Duration(float64(delay) * p.Multiplier)
+	}
+	if delay > p.MaxBackoff {
+		delay = p.MaxBackoff
+	}
+
+	var wait time.Duration
+	switch p.Jitter {
+	case JitterNone:
+		wait = delay
+	case JitterFull:
+		wait = time.Duration(rand.Int63n(int64(delay) + 1))
+	case JitterEqual:
+		half := delay / 2
+		wait = half + time.Duration(rand.Int63n(int64(delay-half)+1))
+	default:
+		wait = delay + time.Duration(rand.Int63n(int64(delay)))
+	}
+	if wait > p.MaxBackoff {
+		wait = p.MaxBackoff
+	}
+	return wait
+}
+
+// RetryWithPolicy executes fn until it succeeds, maxRetries retries have been made, the policy's
+// classifier rejects the latest error, or ctx is done. Waits between attempts honour ctx and, unless
+// disabled, the Retry-After duration reported by the service, capped at the policy's MaxBackoff. When
+// ctx ends during a wait, ctx.Err() is returned.
+func RetryWithPolicy(ctx context.Context, maxRetries int, policy RetryPolicy, fn func() error) error {
+	if ctx == nil {
+		ctx = context.Background()
+	}
 	if maxRetries < 0 {
 		maxRetries = 0
 	}
-	var lastErr error
-	delay := defaultInitialBackoff
+	policy = policy.withDefaults()
 
-	for attempt := 0; attempt <= maxRetries; attempt++ {
-		if attempt > 0 {
-			jitter := time.Duration(rand.Int63n(int64(delay)))
-			sleepFor := delay + jitter
-			if sleepFor > defaultMaxBackoff {
-				sleepFor = defaultMaxBackoff
-			}
-			time.Sleep(sleepFor)
-			next := time.Duration(float64(delay) * backoffMultiplier)
-			if next > defaultMaxBackoff {
-				next = defaultMaxBackoff
-			}
-			delay = next
+	var timer *time.Timer
+	defer func() {
+		if timer != nil {
+			timer.Stop()
 		}
+	}()
 
-		if err := fn(); err != nil {
-			lastErr = err
-			if shouldRetry != nil && !shouldRetry(err) {
-				return err
-			}
-			if attempt == maxRetries {
-				return err
+	for attempt := 0; ; attempt++ {
+		err := fn()
+		if err == nil {
+			return nil
+		}
+		if attempt >= maxRetries || !policy.ShouldRetry(err) {
+			return err
+		}
+
+		wait := policy.Backoff(attempt + 1)
+		if !policy.IgnoreRetryAfter {
+			if sdkErr, ok := err.(*model.Error); ok && sdkErr.RetryAfter > wait {
+				wait = sdkErr.RetryAfter
+				if wait > policy.MaxBackoff {
+					wait = policy.MaxBackoff
+				}
 			}
-			continue
 		}
-		return nil
+
+		if timer == nil {
+			timer = time.NewTimer(wait)
+		} else {
+			timer.Reset(wait)
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-timer.C:
+		}
 	}
+}
 
-	return lastErr
+// Retry executes fn with exponential backoff. Retries stop when fn returns nil, the max retry count is reached,
+// or shouldRetry returns false for the latest error.
+func Retry(maxRetries int, fn func() error, shouldRetry func(error) bool) error {
+	policy := DefaultRetryPolicy()
+	policy.ShouldRetry = func(error) bool { return true }
+	if shouldRetry != nil {
+		policy.ShouldRetry = shouldRetry
+	}
+	return RetryWithPolicy(context.Background(), maxRetries, policy, fn)
 }
 
 // IsRetryableError delegates to model.IsRetryableError for backward compatibility.
@@ -0,0 +1,143 @@
+// Copyright (c) 2025 Beijing Volcano Engine Technology Co., Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package utils
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/volcengine/vikingdb-go-sdk/vector/model"
+)
+
+func TestRetryWithPolicy(t *testing.T) {
+	retryable := model.NewServiceUnavailableError("unavailable")
+	fatal := model.NewInvalidParameterError("bad")
+	throttled := func(after time.Duration) error {
+		err := model.NewErrorWithStatusCode(model.ErrCodeRequestLimitExceeded, "slow down", http.StatusTooManyRequests)
+		err.RetryAfter = after
+		return err
+	}
+	fast := RetryPolicy{InitialBackoff: time.Millisecond, MaxBackoff: 5 * time.Millisecond, Jitter: JitterNone}
+
+	cases := []struct {
+		name         string
+		maxRetries   int
+		policy       RetryPolicy
+		errs         []error
+		wantAttempts int
+		wantErr      error
+		minElapsed   time.Duration
+		maxElapsed   time.Duration
+	}{
+		{name: "succeeds after retries", maxRetries: 3, policy: fast, errs: []error{retryable, retryable}, wantAttempts: 3},
+		{name: "gives up after max retries", maxRetries: 2, policy: fast, errs: []error{retryable, retryable, retryable, retryable}, wantAttempts: 3, wantErr: retryable},
+		{name: "does not retry non-retryable errors", maxRetries: 3, policy: fast, errs: []error{fatal}, wantAttempts: 1, wantErr: fatal},
+		{name: "no retries configured", maxRetries: 0, policy: fast, errs: []error{retryable}, wantAttempts: 1, wantErr: retryable},
+		{
+			name:       "honours Retry-After",
+			maxRetries: 1,
+			policy:     RetryPolicy{InitialBackoff: time.Millisecond, MaxBackoff: time.Second, Jitter: JitterNone},
+			errs:       []error{throttled(50 * time.Millisecond)}, wantAttempts: 2,
+			minElapsed: 50 * time.Millisecond,
+		},
+		{
+			name:       "caps Retry-After at MaxBackoff",
+			maxRetries: 1,
+			policy:     RetryPolicy{InitialBackoff: time.Millisecond, MaxBackoff: 20 * time.Millisecond, Jitter: JitterNone},
+			errs:       []error{throttled(24 * time.Hour)}, wantAttempts: 2,
+			minElapsed: 20 * time.Millisecond, maxElapsed: 2 * time.Second,
+		},
+		{
+			name:       "ignores Retry-After when disabled",
+			maxRetries: 1,
+			policy:     RetryPolicy{InitialBackoff: time.Millisecond, MaxBackoff: time.Second, Jitter: JitterNone, IgnoreRetryAfter: true},
+			errs:       []error{throttled(24 * time.Hour)}, wantAttempts: 2,
+			maxElapsed: 2 * time.Second,
+		},
+		{
+			name:       "custom classifier",
+			maxRetries: 3,
+			policy:     RetryPolicy{InitialBackoff: time.Millisecond, ShouldRetry: func(err error) bool { return err == fatal }},
+			errs:       []error{fatal, retryable}, wantAttempts: 2, wantErr: retryable,
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			attempts := 0
+			start := time.Now()
+			err := RetryWithPolicy(context.Background(), tc.maxRetries, tc.policy, func() error {
+				attempts++
+				if attempts <= len(tc.errs) {
+					return tc.errs[attempts-1]
+				}
+				return nil
+			})
+			elapsed := time.Since(start)
+			if err != tc.wantErr {
+				t.Fatalf("err = %v, want %v", err, tc.wantErr)
+			}
+			if attempts != tc.wantAttempts {
+				t.Fatalf("attempts = %d, want %d", attempts, tc.wantAttempts)
+			}
+			if elapsed < tc.minElapsed {
+				t.Fatalf("returned after %v, want at least %v", elapsed, tc.minElapsed)
+			}
+			if tc.maxElapsed > 0 && elapsed > tc.maxElapsed {
+				t.Fatalf("returned after %v, want at most %v", elapsed, tc.maxElapsed)
+			}
+		})
+	}
+}
+
+func TestRetryWithPolicyStopsWhenContextEnds(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	policy := RetryPolicy{InitialBackoff: time.Hour, MaxBackoff: time.Hour, Jitter: JitterNone}
+	start := time.Now()
+	err := RetryWithPolicy(ctx, 5, policy, func() error { return model.NewServiceUnavailableError("unavailable") })
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("err = %v, want deadline exceeded", err)
+	}
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Fatalf("wait ignored the context: returned after %v", elapsed)
+	}
+}
+
+func TestBackoff(t *testing.T) {
+	policy := RetryPolicy{InitialBackoff: 10 * time.Millisecond, MaxBackoff: 50 * time.Millisecond, Multiplier: 2, Jitter: JitterNone}
+	for retry, want := range map[int]time.Duration{1: 10 * time.Millisecond, 2: 20 * time.Millisecond, 3: 40 * time.Millisecond, 4: 50 * time.Millisecond, 10: 50 * time.Millisecond} {
+		if got := policy.Backoff(retry); got != want {
+			t.Errorf("Backoff(%d) = %v, want %v", retry, got, want)
+		}
+	}
+
+	for _, jitter := range []JitterStrategy{JitterAdditive, JitterFull, JitterEqual} {
+		policy.Jitter = jitter
+		for i := 0; i < 100; i++ {
+			if got := policy.Backoff(2); got < 0 || got > policy.MaxBackoff {
+				t.Fatalf("jitter %d: Backoff(2) = %v outside [0, %v]", jitter, got, policy.MaxBackoff)
+			}
+		}
+	}
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	cases := map[string]time.Duration{
+		"":                              0,
+		"3":                             3 * time.Second,
+		"-1":                            0,
+		"soon":                          0,
+		"Wed, 01 Jan 2025 00:00:30 GMT": 30 * time.Second,
+		"Tue, 31 Dec 2024 23:59:00 GMT": 0,
+	}
+	for value, want := range cases {
+		if got := ParseRetryAfter(value, now); got != want {
+			t.Errorf("ParseRetryAfter(%q) = %v, want %v", value, got, want)
+		}
+	}
+}
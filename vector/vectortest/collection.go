@@ -0,0 +1,206 @@
+// Copyright (c) 2025 Beijing Volcano Engine Technology Co., Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package vectortest
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"net/http"
+
+	"github.com/volcengine/vikingdb-go-sdk/vector/model"
+)
+
+type document struct {
+	id     interface{}
+	fields model.MapStr
+	seq    int
+}
+
+type collection struct {
+	config CollectionConfig
+	docs   map[string]*document
+	order  []string
+	seq    int
+}
+
+func newCollection(config CollectionConfig) *collection {
+	return &collection{config: config, docs: make(map[string]*document)}
+}
+
+// idKey canonicalises a primary key so 1, json.Number("1") and 1.0 address the same document.
+func idKey(id interface{}) string {
+	if f, ok := toFloat(id); ok {
+		return fmt.Sprintf("n:%v", f)
+	}
+	return fmt.Sprintf("s:%v", id)
+}
+
+func (c *collection) get(id interface{}) (*document, bool) {
+	doc, ok := c.docs[idKey(id)]
+	return doc, ok
+}
+
+func (c *collection) upsert(docs []model.MapStr) *model.Error {
+	for _, fields := range docs {
+		id, ok := fields[c.config.PrimaryKey]
+		if !ok || id == nil {
+			return invalidParameter("document is missing primary key %q", c.config.PrimaryKey)
+		}
+		if err := c.checkVector(fields); err != nil {
+			return err
+		}
+	}
+	for _, fields := range docs {
+		id := fields[c.config.PrimaryKey]
+		key := idKey(id)
+		if existing, ok := c.docs[key]; ok {
+			existing.fields = copyFields(fields)
+			continue
+		}
+		c.seq++
+		c.docs[key] = &document{id: id, fields: copyFields(fields), seq: c.seq}
+		c.order = append(c.order, key)
+	}
+	return nil
+}
+
+func (c *collection) update(docs []model.MapStr) *model.Error {
+	for _, fields := range docs {
+		id, ok := fields[c.config.PrimaryKey]
+		if !ok || id == nil {
+			return invalidParameter("document is missing primary key %q", c.config.PrimaryKey)
+		}
+		if _, exists := c.get(id); !exists {
+			return model.NewErrorWithStatusCode(model.ErrCodeDataNotFound, fmt.Sprintf("document %v does not exist", id), http.StatusNotFound)
+		}
+		if err := c.checkVector(fields); err != nil {
+			return err
+		}
+	}
+	for _, fields := range docs {
+		doc, _ := c.get(fields[c.config.PrimaryKey])
+		for k, v := range fields {
+			doc.fields[k] = v
+		}
+	}
+	return nil
+}
+
+func (c *collection) delete(ids []interface{}, all bool) {
+	if all {
+		c.docs = make(map[string]*document)
+		c.order = nil
+		return
+	}
+	for _, id := range ids {
+		delete(c.docs, idKey(id))
+	}
+	kept := c.order[:0]
+	for _, key := range c.order {
+		if _, ok := c.docs[key]; ok {
+			kept = append(kept, key)
+		}
+	}
+	c.order = kept
+}
+
+// documents returns the live documents in insertion order.
+func (c *collection) documents() []*document {
+	out := make([]*document, 0, len(c.order))
+	for _, key := range c.order {
+		out = append(out, c.docs[key])
+	}
+	return out
+}
+
+func (c *collection) checkVector(fields model.MapStr) *model.Error {
+	raw, ok := fields[c.config.VectorField]
+	if !ok {
+		return nil
+	}
+	if _, ok := toVector(raw); !ok {
+		return invalidParameter("field %q must be a list of numbers", c.config.VectorField)
+	}
+	return nil
+}
+
+func (c *collection) vector(doc *document) ([]float64, bool) {
+	raw, ok := doc.fields[c.config.VectorField]
+	if !ok {
+		return nil, false
+	}
+	return toVector(raw)
+}
+
+func (c *collection) similarity(a, b []float64) float64 {
+	var dot, na, nb float64
+	for i := range a {
+		dot += a[i] * b[i]
+		na += a[i] * a[i]
+		nb += b[i] * b[i]
+	}
+	if c.config.Metric == MetricIP {
+		return dot
+	}
+	if na == 0 || nb == 0 {
+		return 0
+	}
+	return dot / (math.Sqrt(na) * math.Sqrt(nb))
+}
+
+func toVector(raw interface{}) ([]float64, bool) {
+	switch v := raw.(type) {
+	case []float64:
+		return v, true
+	case []float32:
+		out := make([]float64, len(v))
+		for i, x := range v {
+			out[i] = float64(x)
+		}
+		return out, true
+	case []interface{}:
+		out := make([]float64, len(v))
+		for i, x := range v {
+			f, ok := toFloat(x)
+			if !ok {
+				return nil, false
+			}
+			out[i] = f
+		}
+		return out, true
+	}
+	return nil, false
+}
+
+func toFloat(value interface{}) (float64, bool) {
+	switch n := value.(type) {
+	case json.Number:
+		f, err := n.Float64()
+		return f, err == nil
+	case float64:
+		return n, true
+	case float32:
+		return float64(n), true
+	case int:
+		return float64(n), true
+	case int32:
+		return float64(n), true
+	case int64:
+		return float64(n), true
+	case uint32:
+		return float64(n), true
+	case uint64:
+		return float64(n), true
+	}
+	return 0, false
+}
+
+func copyFields(fields model.MapStr) model.MapStr {
+	out := make(model.MapStr, len(fields))
+	for k, v := range fields {
+		out[k] = v
+	}
+	return out
+}
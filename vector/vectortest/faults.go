@@ -0,0 +1,254 @@
+// Copyright (c) 2025 Beijing Volcano Engine Technology Co., Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package vectortest
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"math/rand"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/volcengine/vikingdb-go-sdk/vector/model"
+)
+
+type faultKind int
+
+const (
+	faultNone faultKind = iota
+	faultReset
+	faultStatus
+	faultTruncate
+	faultMalformed
+)
+
+// Fault is a failure injected by a FaultTransport.
+type Fault struct {
+	kind       faultKind
+	latency    time.Duration
+	status     int
+	code       model.ErrorCode
+	message    string
+	retryAfter time.Duration
+}
+
+// Latency delays the request by d and then sends it unchanged.
+func Latency(d time.Duration) Fault {
+	return Fault{latency: d}
+}
+
+// ConnectionReset fails the request as if the peer reset the connection.
+func ConnectionReset() Fault {
+	return Fault{kind: faultReset}
+}
+
+// TooManyRequests answers 429 with a Retry-After header of retryAfter, rounded up to whole seconds.
+func TooManyRequests(retryAfter time.Duration) Fault {
+	return Fault{kind: faultStatus, status: http.StatusTooManyRequests, code: model.ErrCodeRequestLimitExceeded, message: "request limit exceeded", retryAfter: retryAfter}
+}
+
+// ServerError answers status with a VikingDB JSON error body carrying code and message.
+func ServerError(status int, code model.ErrorCode, message string) Fault {
+	return Fault{kind: faultStatus, status: status, code: code, message: message}
+}
+
+// TruncatedBody sends the request but cuts the response body in half, failing the read with
+// io.ErrUnexpectedEOF.
+func TruncatedBody() Fault {
+	return Fault{kind: faultTruncate}
+}
+
+// MalformedJSON answers 200 with a body that is not valid JSON.
+func MalformedJSON() Fault {
+	return Fault{kind: faultMalformed}
+}
+
+// After returns a copy of f that first waits d.
+func (f Fault) After(d time.Duration) Fault {
+	f.latency = d
+	return f
+}
+
+// FaultRule injects Fault into the requests it matches.
+type FaultRule struct {
+	// Path matches the request path exactly, or as a prefix when it ends with "*". Empty matches every path.
+	Path string
+	// Probability of injecting the fault into a matching request; zero means always.
+	Probability float64
+	// Times caps the number of injections; zero means unlimited.
+	Times int
+	Fault Fault
+}
+
+func (r FaultRule) matches(path string) bool {
+	switch {
+	case r.Path == "":
+		return true
+	case strings.HasSuffix(r.Path, "*"):
+		return strings.HasPrefix(path, strings.TrimSuffix(r.Path, "*"))
+	}
+	return path == r.Path
+}
+
+// FaultTransport is an http.RoundTripper injecting failures into the requests matched by its rules. The
+// first applicable rule wins; unmatched requests go to the next transport untouched. Plug it in with
+// vector.WithHTTPClient(ft.Client()).
+type FaultTransport struct {
+	next http.RoundTripper
+
+	mu       sync.Mutex
+	rules    []FaultRule
+	injected []int
+	rand     *rand.Rand
+}
+
+// NewFaultTransport wraps next (http.DefaultTransport when nil). Probabilistic rules use a fixed seed, so
+// runs are reproducible.
+func NewFaultTransport(next http.RoundTripper, rules ...FaultRule) *FaultTransport {
+	if next == nil {
+		next = http.DefaultTransport
+	}
+	return &FaultTransport{
+		next:     next,
+		rules:    append([]FaultRule(nil), rules...),
+		injected: make([]int, len(rules)),
+		rand:     rand.New(rand.NewSource(1)),
+	}
+}
+
+// AddRule appends a rule.
+func (t *FaultTransport) AddRule(rule FaultRule) {
+	t.mu.Lock()
+	t.rules = append(t.rules, rule)
+	t.injected = append(t.injected, 0)
+	t.mu.Unlock()
+}
+
+// Injected returns how many faults have been injected in total.
+func (t *FaultTransport) Injected() int {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	total := 0
+	for _, n := range t.injected {
+		total += n
+	}
+	return total
+}
+
+// ClientTimeout bounds every request sent through the client returned by FaultTransport.Client, so an
+// injected latency cannot hang a test that forgot to set a deadline. It matches the vector client default.
+const ClientTimeout = 30 * time.Second
+
+// Client returns an http.Client using the transport, with ClientTimeout as its timeout. Tests injecting
+// longer latencies should build their own http.Client around the transport.
+func (t *FaultTransport) Client() *http.Client {
+	return &http.Client{Transport: t, Timeout: ClientTimeout}
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *FaultTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	fault, requestID, ok := t.pick(req.URL.Path)
+	if !ok {
+		return t.next.RoundTrip(req)
+	}
+
+	if fault.latency > 0 {
+		timer := time.NewTimer(fault.latency)
+		select {
+		case <-timer.C:
+		case <-req.Context().Done():
+			timer.Stop()
+			return nil, req.Context().Err()
+		}
+	}
+
+	switch fault.kind {
+	case faultReset:
+		closeBody(req)
+		return nil, &net.OpError{Op: "read", Net: "tcp", Err: syscall.ECONNRESET}
+	case faultStatus:
+		closeBody(req)
+		body, _ := json.Marshal(map[string]string{
+			"code":       string(fault.code),
+			"message":    fault.message,
+			"request_id": requestID,
+		})
+		resp := faultResponse(req, fault.status, body)
+		if fault.retryAfter > 0 {
+			seconds := int((fault.retryAfter + time.Second - 1) / time.Second)
+			resp.Header.Set("Retry-After", strconv.Itoa(seconds))
+		}
+		return resp, nil
+	case faultMalformed:
+		closeBody(req)
+		return faultResponse(req, http.StatusOK, []byte(`{"code":"Success","result":{"data":[`)), nil
+	case faultTruncate:
+		resp, err := t.next.RoundTrip(req)
+		if err != nil {
+			return nil, err
+		}
+		body, err := ioutil.ReadAll(resp.Body)
+		resp.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		resp.Body = ioutil.NopCloser(io.MultiReader(bytes.NewReader(body[:len(body)/2]), errReader{io.ErrUnexpectedEOF}))
+		return resp, nil
+	}
+	return t.next.RoundTrip(req)
+}
+
+func (t *FaultTransport) pick(path string) (Fault, string, bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	for i, rule := range t.rules {
+		if !rule.matches(path) || (rule.Times > 0 && t.injected[i] >= rule.Times) {
+			continue
+		}
+		if rule.Probability > 0 && t.rand.Float64() >= rule.Probability {
+			continue
+		}
+		t.injected[i]++
+		return rule.Fault, fmt.Sprintf("fault-%d-%d", i, t.injected[i]), true
+	}
+	return Fault{}, "", false
+}
+
+func closeBody(req *http.Request) {
+	if req.Body != nil {
+		req.Body.Close()
+	}
+}
+
+func faultResponse(req *http.Request, status int, body []byte) *http.Response {
+	header := make(http.Header)
+	header.Set("Content-Type", "application/json")
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", status, http.StatusText(status)),
+		StatusCode:    status,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          ioutil.NopCloser(bytes.NewReader(body)),
+		ContentLength: int64(len(body)),
+		Request:       req,
+	}
+}
+
+type errReader struct {
+	err error
+}
+
+func (r errReader) Read([]byte) (int, error) {
+	return 0, r.err
+}
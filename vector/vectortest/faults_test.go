@@ -0,0 +1,173 @@
+// Copyright (c) 2025 Beijing Volcano Engine Technology Co., Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package vectortest_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/volcengine/vikingdb-go-sdk/vector"
+	"github.com/volcengine/vikingdb-go-sdk/vector/model"
+	"github.com/volcengine/vikingdb-go-sdk/vector/utils"
+	"github.com/volcengine/vikingdb-go-sdk/vector/vectortest"
+)
+
+const searchPath = "/api/vikingdb/data/search/vector"
+
+func TestFaultTransport(t *testing.T) {
+	fast := utils.RetryPolicy{InitialBackoff: time.Millisecond, MaxBackoff: 50 * time.Millisecond, Jitter: utils.JitterNone}
+	cases := []struct {
+		name         string
+		rule         vectortest.FaultRule
+		maxRetries   int
+		policy       utils.RetryPolicy
+		timeout      time.Duration
+		wantErr      bool
+		wantCode     model.ErrorCode
+		wantInjected int
+		minElapsed   time.Duration
+		maxElapsed   time.Duration
+	}{
+		{
+			name:       "connection reset is retried",
+			rule:       vectortest.FaultRule{Path: searchPath, Times: 1, Fault: vectortest.ConnectionReset()},
+			maxRetries: 1, policy: fast, wantInjected: 1,
+		},
+		{
+			name:       "server error is retried",
+			rule:       vectortest.FaultRule{Path: "/api/vikingdb/data/*", Times: 2, Fault: vectortest.ServerError(http.StatusServiceUnavailable, model.ErrCodeServiceUnavailable, "down")},
+			maxRetries: 2, policy: fast, wantInjected: 2,
+		},
+		{
+			name:       "client error is not retried",
+			rule:       vectortest.FaultRule{Fault: vectortest.ServerError(http.StatusBadRequest, model.ErrCodeInvalidParameter, "bad")},
+			maxRetries: 3, policy: fast, wantErr: true, wantCode: model.ErrCodeInvalidParameter, wantInjected: 1,
+		},
+		{
+			name:       "retry budget runs out",
+			rule:       vectortest.FaultRule{Fault: vectortest.ServerError(http.StatusBadGateway, model.ErrCodeServiceUnavailable, "down")},
+			maxRetries: 2, policy: fast, wantErr: true, wantInjected: 3,
+		},
+		{
+			name:       "Retry-After is honoured",
+			rule:       vectortest.FaultRule{Times: 1, Fault: vectortest.TooManyRequests(time.Second)},
+			maxRetries: 1, policy: utils.RetryPolicy{InitialBackoff: time.Millisecond, MaxBackoff: 5 * time.Second, Jitter: utils.JitterNone},
+			wantInjected: 1, minElapsed: time.Second,
+		},
+		{
+			name:       "Retry-After is capped at MaxBackoff",
+			rule:       vectortest.FaultRule{Times: 1, Fault: vectortest.TooManyRequests(time.Hour)},
+			maxRetries: 1, policy: fast, wantInjected: 1, maxElapsed: time.Second,
+		},
+		{
+			name:       "malformed JSON",
+			rule:       vectortest.FaultRule{Fault: vectortest.MalformedJSON()},
+			maxRetries: 0, policy: fast, wantErr: true, wantInjected: 1,
+		},
+		{
+			name:       "truncated body",
+			rule:       vectortest.FaultRule{Fault: vectortest.TruncatedBody()},
+			maxRetries: 0, policy: fast, wantErr: true, wantInjected: 1,
+		},
+		{
+			name:       "latency respects the caller's deadline",
+			rule:       vectortest.FaultRule{Fault: vectortest.Latency(time.Hour)},
+			maxRetries: 0, policy: fast, timeout: 20 * time.Millisecond, wantErr: true, wantInjected: 1, maxElapsed: time.Second,
+		},
+		{
+			name:       "rules for other paths are skipped",
+			rule:       vectortest.FaultRule{Path: "/api/vikingdb/data/upsert", Fault: vectortest.ConnectionReset()},
+			maxRetries: 0, policy: fast, wantInjected: 0,
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			srv := vectortest.NewServer()
+			defer srv.Close()
+			if err := srv.Insert("docs", model.MapStr{"id": 1, "vector": []float64{1, 0}}); err != nil {
+				t.Fatal(err)
+			}
+			ft := vectortest.NewFaultTransport(nil, tc.rule)
+			client, err := srv.NewClient(vector.WithHTTPClient(ft.Client()), vector.WithMaxRetries(tc.maxRetries), vector.WithRetryPolicy(tc.policy))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			ctx := context.Background()
+			if tc.timeout > 0 {
+				var cancel context.CancelFunc
+				ctx, cancel = context.WithTimeout(ctx, tc.timeout)
+				defer cancel()
+			}
+			start := time.Now()
+			resp, err := client.Index(testIndex).SearchByVector(ctx, model.SearchByVectorRequest{DenseVector: []float64{1, 0}})
+			elapsed := time.Since(start)
+
+			if tc.wantErr != (err != nil) {
+				t.Fatalf("err = %v, want error %v", err, tc.wantErr)
+			}
+			if tc.wantCode != "" {
+				var sdkErr *model.Error
+				if !errors.As(err, &sdkErr) || sdkErr.Code != tc.wantCode {
+					t.Fatalf("err = %v, want code %s", err, tc.wantCode)
+				}
+			}
+			if err == nil && len(resp.Result.Data) != 1 {
+				t.Fatalf("unexpected result %+v", resp.Result)
+			}
+			if got := ft.Injected(); got != tc.wantInjected {
+				t.Fatalf("injected %d faults, want %d", got, tc.wantInjected)
+			}
+			if elapsed < tc.minElapsed {
+				t.Fatalf("returned after %v, want at least %v", elapsed, tc.minElapsed)
+			}
+			if tc.maxElapsed > 0 && elapsed > tc.maxElapsed {
+				t.Fatalf("returned after %v, want at most %v", elapsed, tc.maxElapsed)
+			}
+		})
+	}
+}
+
+func TestFaultProbabilityIsReproducible(t *testing.T) {
+	run := func() []bool {
+		ft := vectortest.NewFaultTransport(http.NewFileTransport(http.Dir(t.TempDir())), vectortest.FaultRule{Probability: 0.5, Fault: vectortest.ConnectionReset()})
+		var outcomes []bool
+		for i := 0; i < 20; i++ {
+			req, _ := http.NewRequest(http.MethodGet, "http://fake/x", nil)
+			resp, err := ft.RoundTrip(req)
+			if resp != nil {
+				resp.Body.Close()
+			}
+			outcomes = append(outcomes, err != nil)
+		}
+		return outcomes
+	}
+	first, second := run(), run()
+	injected := 0
+	for i := range first {
+		if first[i] != second[i] {
+			t.Fatalf("request %d differs between runs", i)
+		}
+		if first[i] {
+			injected++
+		}
+	}
+	if injected == 0 || injected == len(first) {
+		t.Fatalf("probability 0.5 injected %d of %d faults", injected, len(first))
+	}
+}
+
+func TestFaultClientHasATimeout(t *testing.T) {
+	ft := vectortest.NewFaultTransport(nil)
+	client := ft.Client()
+	if client.Transport != ft {
+		t.Fatalf("transport = %T, want the fault transport", client.Transport)
+	}
+	if client.Timeout != vectortest.ClientTimeout || client.Timeout <= 0 {
+		t.Fatalf("timeout = %v, want %v", client.Timeout, vectortest.ClientTimeout)
+	}
+}
@@ -0,0 +1,202 @@
+// Copyright (c) 2025 Beijing Volcano Engine Technology Co., Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package vectortest
+
+import (
+	"math"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/volcengine/vikingdb-go-sdk/vector/model"
+)
+
+const earthRadiusMeters = 6371000
+
+// matches evaluates a filter expression in the wire format produced by the filter package.
+func matches(fields model.MapStr, expr map[string]interface{}) (bool, *model.Error) {
+	if len(expr) == 0 {
+		return true, nil
+	}
+	op, _ := expr["op"].(string)
+	field, _ := expr["field"].(string)
+	value, present := fields[field]
+
+	switch op {
+	case "and", "or":
+		conds, _ := expr["conds"].([]interface{})
+		for _, raw := range conds {
+			cond, ok := asMap(raw)
+			if !ok {
+				return false, invalidParameter("filter %s: conditions must be objects", op)
+			}
+			ok, err := matches(fields, cond)
+			if err != nil {
+				return false, err
+			}
+			if op == "and" && !ok {
+				return false, nil
+			}
+			if op == "or" && ok {
+				return true, nil
+			}
+		}
+		return op == "and", nil
+	case "must", "must_not":
+		conds, _ := expr["conds"].([]interface{})
+		hit := present && containsAny(value, conds)
+		return hit == (op == "must"), nil
+	case "range", "range_out":
+		if !present {
+			return false, nil
+		}
+		in, err := inRange(value, expr)
+		if err != nil {
+			return false, err
+		}
+		return in == (op == "range"), nil
+	case "prefix", "contains":
+		needle, _ := expr[op].(string)
+		text, ok := value.(string)
+		if !present || !ok {
+			return false, nil
+		}
+		if op == "prefix" {
+			return strings.HasPrefix(text, needle), nil
+		}
+		return strings.Contains(text, needle), nil
+	case "geo_range":
+		if !present {
+			return false, nil
+		}
+		return inGeoRange(value, expr)
+	}
+	return false, invalidParameter("unsupported filter op %q", op)
+}
+
+func asMap(raw interface{}) (map[string]interface{}, bool) {
+	switch m := raw.(type) {
+	case map[string]interface{}:
+		return m, true
+	case model.MapStr:
+		return m, true
+	}
+	return nil, false
+}
+
+// containsAny reports whether value, or any element of a list value, equals one of conds. Lists may be
+// decoded JSON arrays or typed slices such as []string stored through Server.Insert.
+func containsAny(value interface{}, conds []interface{}) bool {
+	values := []interface{}{value}
+	if list := reflect.ValueOf(value); list.Kind() == reflect.Slice {
+		values = make([]interface{}, list.Len())
+		for i := range values {
+			values[i] = list.Index(i).Interface()
+		}
+	}
+	for _, v := range values {
+		for _, cond := range conds {
+			if equal(v, cond) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func equal(a, b interface{}) bool {
+	if fa, ok := toFloat(a); ok {
+		fb, ok := toFloat(b)
+		return ok && fa == fb
+	}
+	switch va := a.(type) {
+	case string:
+		vb, ok := b.(string)
+		return ok && va == vb
+	case bool:
+		vb, ok := b.(bool)
+		return ok && va == vb
+	}
+	return false
+}
+
+func inRange(value interface{}, expr map[string]interface{}) (bool, *model.Error) {
+	for _, key := range []string{"gt", "gte", "lt", "lte"} {
+		bound, ok := expr[key]
+		if !ok {
+			continue
+		}
+		cmp, comparable := compare(value, bound)
+		if !comparable {
+			return false, nil
+		}
+		switch {
+		case key == "gt" && cmp <= 0, key == "gte" && cmp < 0, key == "lt" && cmp >= 0, key == "lte" && cmp > 0:
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// compare orders numbers numerically and strings lexically.
+func compare(a, b interface{}) (int, bool) {
+	if fa, ok := toFloat(a); ok {
+		fb, ok := toFloat(b)
+		if !ok {
+			return 0, false
+		}
+		switch {
+		case fa < fb:
+			return -1, true
+		case fa > fb:
+			return 1, true
+		}
+		return 0, true
+	}
+	sa, okA := a.(string)
+	sb, okB := b.(string)
+	if !okA || !okB {
+		return 0, false
+	}
+	return strings.Compare(sa, sb), true
+}
+
+func inGeoRange(value interface{}, expr map[string]interface{}) (bool, *model.Error) {
+	center, ok := toVector(expr["center"])
+	if !ok || len(center) != 2 {
+		return false, invalidParameter("geo_range: center must be [longitude, latitude]")
+	}
+	radius, ok := toFloat(expr["radius"])
+	if !ok {
+		return false, invalidParameter("geo_range: radius must be a number")
+	}
+	point, ok := toGeoPoint(value)
+	if !ok {
+		return false, nil
+	}
+	return haversine(center[0], center[1], point[0], point[1]) <= radius, nil
+}
+
+// toGeoPoint accepts "longitude,latitude" strings and [longitude, latitude] lists.
+func toGeoPoint(value interface{}) ([]float64, bool) {
+	if text, ok := value.(string); ok {
+		parts := strings.Split(text, ",")
+		if len(parts) != 2 {
+			return nil, false
+		}
+		lon, err1 := strconv.ParseFloat(strings.TrimSpace(parts[0]), 64)
+		lat, err2 := strconv.ParseFloat(strings.TrimSpace(parts[1]), 64)
+		return []float64{lon, lat}, err1 == nil && err2 == nil
+	}
+	point, ok := toVector(value)
+	return point, ok && len(point) == 2
+}
+
+func haversine(lon1, lat1, lon2, lat2 float64) float64 {
+	toRad := math.Pi / 180
+	dLat := (lat2 - lat1) * toRad
+	dLon := (lon2 - lon1) * toRad
+	h := math.Sin(dLat/2)*math.Sin(dLat/2) + math.Cos(lat1*toRad)*math.Cos(lat2*toRad)*math.Sin(dLon/2)*math.Sin(dLon/2)
+	return 2 * earthRadiusMeters * math.Asin(math.Sqrt(h))
+}
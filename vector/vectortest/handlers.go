@@ -0,0 +1,467 @@
+// Copyright (c) 2025 Beijing Volcano Engine Technology Co., Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package vectortest
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/volcengine/vikingdb-go-sdk/vector/model"
+)
+
+func (s *Server) handleUpsert(body []byte) (interface{}, *model.Error) {
+	var req struct {
+		model.CollectionLocator
+		model.UpsertDataRequest
+	}
+	if err := decode(body, &req); err != nil {
+		return nil, err
+	}
+	c, err := locate(s, req.CollectionLocator)
+	if err != nil {
+		return nil, err
+	}
+	if err := c.upsert(req.Data); err != nil {
+		return nil, err
+	}
+	return model.UpsertDataResult{}, nil
+}
+
+func (s *Server) handleUpdate(body []byte) (interface{}, *model.Error) {
+	var req struct {
+		model.CollectionLocator
+		model.UpdateDataRequest
+	}
+	if err := decode(body, &req); err != nil {
+		return nil, err
+	}
+	c, err := locate(s, req.CollectionLocator)
+	if err != nil {
+		return nil, err
+	}
+	if err := c.update(req.Data); err != nil {
+		return nil, err
+	}
+	return model.UpdateDataResult{}, nil
+}
+
+func (s *Server) handleDelete(body []byte) (interface{}, *model.Error) {
+	var req struct {
+		model.CollectionLocator
+		model.DeleteDataRequest
+	}
+	if err := decode(body, &req); err != nil {
+		return nil, err
+	}
+	c, err := locate(s, req.CollectionLocator)
+	if err != nil {
+		return nil, err
+	}
+	c.delete(req.IDs, req.DelAll)
+	return nil, nil
+}
+
+func (s *Server) handleFetchInCollection(body []byte) (interface{}, *model.Error) {
+	var req struct {
+		model.CollectionLocator
+		model.FetchDataInCollectionRequest
+	}
+	if err := decode(body, &req); err != nil {
+		return nil, err
+	}
+	c, err := locate(s, req.CollectionLocator)
+	if err != nil {
+		return nil, err
+	}
+	result := model.FetchDataInCollectionResult{}
+	for _, id := range req.IDs {
+		doc, ok := c.get(id)
+		if !ok {
+			result.NotFoundIDs = append(result.NotFoundIDs, id)
+			continue
+		}
+		result.Items = append(result.Items, model.DataItem{ID: doc.id, Fields: c.project(doc, nil, true)})
+	}
+	return result, nil
+}
+
+func (s *Server) handleFetchInIndex(body []byte) (interface{}, *model.Error) {
+	var req struct {
+		model.IndexLocator
+		model.FetchDataInIndexRequest
+	}
+	if err := decode(body, &req); err != nil {
+		return nil, err
+	}
+	c, err := locate(s, req.CollectionLocator)
+	if err != nil {
+		return nil, err
+	}
+	result := model.FetchDataInIndexResult{}
+	for _, id := range req.IDs {
+		doc, ok := c.get(id)
+		if !ok || !c.inPartition(doc, req.Partition) {
+			result.NotFoundIDs = append(result.NotFoundIDs, id)
+			continue
+		}
+		item := model.IndexDataItem{DataItem: model.DataItem{ID: doc.id, Fields: c.project(doc, req.OutputFields, false)}}
+		if vec, ok := c.vector(doc); ok {
+			item.DenseDim = len(vec)
+			item.DenseVector = make([]float32, len(vec))
+			for i, x := range vec {
+				item.DenseVector[i] = float32(x)
+			}
+		}
+		result.Items = append(result.Items, item)
+	}
+	return result, nil
+}
+
+func (s *Server) handleSearchByVector(body []byte) (interface{}, *model.Error) {
+	var req struct {
+		model.IndexLocator
+		model.SearchByVectorRequest
+	}
+	if err := decode(body, &req); err != nil {
+		return nil, err
+	}
+	c, err := locate(s, req.CollectionLocator)
+	if err != nil {
+		return nil, err
+	}
+	if len(req.DenseVector) == 0 {
+		return nil, invalidParameter("dense_vector is required")
+	}
+	return c.searchByVector(req.DenseVector, req.SearchBase)
+}
+
+func (s *Server) handleSearchByID(body []byte) (interface{}, *model.Error) {
+	var req struct {
+		model.IndexLocator
+		model.SearchByIDRequest
+	}
+	if err := decode(body, &req); err != nil {
+		return nil, err
+	}
+	c, err := locate(s, req.CollectionLocator)
+	if err != nil {
+		return nil, err
+	}
+	doc, ok := c.get(req.ID)
+	if !ok {
+		return nil, model.NewErrorWithStatusCode(model.ErrCodeDataNotFound, fmt.Sprintf("document %v does not exist", req.ID), http.StatusNotFound)
+	}
+	vec, ok := c.vector(doc)
+	if !ok {
+		return nil, invalidParameter("document %v has no %q vector", req.ID, c.config.VectorField)
+	}
+	return c.searchByVector(vec, req.SearchBase)
+}
+
+func (s *Server) handleSearchByScalar(body []byte) (interface{}, *model.Error) {
+	var req struct {
+		model.IndexLocator
+		model.SearchByScalarRequest
+	}
+	if err := decode(body, &req); err != nil {
+		return nil, err
+	}
+	c, err := locate(s, req.CollectionLocator)
+	if err != nil {
+		return nil, err
+	}
+	if req.Field == nil || *req.Field == "" {
+		return nil, invalidParameter("field is required")
+	}
+	field := *req.Field
+	candidates, err := c.candidates(req.SearchBase)
+	if err != nil {
+		return nil, err
+	}
+	var hits []scored
+	for _, doc := range candidates {
+		value, ok := doc.fields[field]
+		if !ok {
+			continue
+		}
+		score, _ := toFloat(value)
+		hits = append(hits, scored{doc: doc, score: score})
+	}
+	descending := req.Order != model.ScalarOrderAsc
+	sort.SliceStable(hits, func(i, j int) bool {
+		cmp, _ := compare(hits[i].doc.fields[field], hits[j].doc.fields[field])
+		if descending {
+			return cmp > 0
+		}
+		return cmp < 0
+	})
+	return c.page(hits, len(candidates), req.SearchBase), nil
+}
+
+func (s *Server) handleSearchByRandom(body []byte) (interface{}, *model.Error) {
+	var req struct {
+		model.IndexLocator
+		model.SearchByRandomRequest
+	}
+	if err := decode(body, &req); err != nil {
+		return nil, err
+	}
+	c, err := locate(s, req.CollectionLocator)
+	if err != nil {
+		return nil, err
+	}
+	candidates, err := c.candidates(req.SearchBase)
+	if err != nil {
+		return nil, err
+	}
+	hits := make([]scored, len(candidates))
+	for i, doc := range candidates {
+		hits[i] = scored{doc: doc}
+	}
+	s.rand.Shuffle(len(hits), func(i, j int) { hits[i], hits[j] = hits[j], hits[i] })
+	return c.page(hits, len(candidates), req.SearchBase), nil
+}
+
+func (s *Server) handleSearchByKeywords(body []byte) (interface{}, *model.Error) {
+	var req struct {
+		model.IndexLocator
+		model.SearchByKeywordsRequest
+	}
+	if err := decode(body, &req); err != nil {
+		return nil, err
+	}
+	c, err := locate(s, req.CollectionLocator)
+	if err != nil {
+		return nil, err
+	}
+	keywords := req.Keywords
+	if len(keywords) == 0 {
+		keywords = strings.Fields(req.Query)
+	}
+	if len(keywords) == 0 {
+		return nil, invalidParameter("keywords or query is required")
+	}
+	if !req.CaseSensitive {
+		for i, keyword := range keywords {
+			keywords[i] = strings.ToLower(keyword)
+		}
+	}
+	candidates, err := c.candidates(req.SearchBase)
+	if err != nil {
+		return nil, err
+	}
+	var hits []scored
+	for _, doc := range candidates {
+		score := 0
+		for _, value := range doc.fields {
+			text, ok := value.(string)
+			if !ok {
+				continue
+			}
+			if !req.CaseSensitive {
+				text = strings.ToLower(text)
+			}
+			for _, keyword := range keywords {
+				score += strings.Count(text, keyword)
+			}
+		}
+		if score > 0 {
+			hits = append(hits, scored{doc: doc, score: float64(score)})
+		}
+	}
+	sortByScore(hits)
+	return c.page(hits, len(candidates), req.SearchBase), nil
+}
+
+func (s *Server) handleAgg(body []byte) (interface{}, *model.Error) {
+	var req struct {
+		model.IndexLocator
+		model.AggRequest
+	}
+	if err := decode(body, &req); err != nil {
+		return nil, err
+	}
+	c, err := locate(s, req.CollectionLocator)
+	if err != nil {
+		return nil, err
+	}
+	if req.Op != "count" {
+		return nil, invalidParameter("unsupported agg op %q", req.Op)
+	}
+	candidates, err := c.candidates(model.SearchBase{RecallBase: req.RecallBase})
+	if err != nil {
+		return nil, err
+	}
+	result := model.AggResult{Op: req.Op, Agg: model.MapStr{}}
+	if req.Field == nil || *req.Field == "" {
+		result.Agg["__TOTAL__"] = len(candidates)
+		return result, nil
+	}
+	result.Field = *req.Field
+	counts := map[string]int{}
+	for _, doc := range candidates {
+		value, ok := doc.fields[result.Field]
+		if !ok {
+			continue
+		}
+		values, isList := value.([]interface{})
+		if !isList {
+			values = []interface{}{value}
+		}
+		for _, v := range values {
+			counts[fmt.Sprint(v)]++
+		}
+	}
+	for key, count := range counts {
+		if req.Cond != nil {
+			if ok, err := inRange(count, req.Cond); err != nil || !ok {
+				continue
+			}
+		}
+		result.Agg[key] = count
+	}
+	return result, nil
+}
+
+type scored struct {
+	doc   *document
+	score float64
+}
+
+func sortByScore(hits []scored) {
+	sort.SliceStable(hits, func(i, j int) bool { return hits[i].score > hits[j].score })
+}
+
+func (c *collection) searchByVector(query []float64, base model.SearchBase) (interface{}, *model.Error) {
+	candidates, err := c.candidates(base)
+	if err != nil {
+		return nil, err
+	}
+	var hits []scored
+	for _, doc := range candidates {
+		vec, ok := c.vector(doc)
+		if !ok {
+			continue
+		}
+		if len(vec) != len(query) {
+			return nil, invalidParameter("dense_vector has dimension %d, documents have %d", len(query), len(vec))
+		}
+		hits = append(hits, scored{doc: doc, score: c.similarity(query, vec)})
+	}
+	sortByScore(hits)
+	return c.page(hits, len(candidates), base), nil
+}
+
+// candidates returns the documents passing the partition, filter and id restrictions of a search.
+func (c *collection) candidates(base model.SearchBase) ([]*document, *model.Error) {
+	var in, notIn map[string]bool
+	if base.Advance != nil {
+		in = idSet(base.Advance.IDsIn)
+		notIn = idSet(base.Advance.IDsNotIn)
+	}
+	var out []*document
+	for _, doc := range c.documents() {
+		key := idKey(doc.id)
+		if (in != nil && !in[key]) || notIn[key] || !c.inPartition(doc, base.Partition) {
+			continue
+		}
+		ok, err := matches(doc.fields, base.Filter)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			out = append(out, doc)
+		}
+	}
+	return out, nil
+}
+
+func (c *collection) inPartition(doc *document, partition string) bool {
+	if partition == "" || c.config.PartitionField == "" {
+		return true
+	}
+	return fmt.Sprint(doc.fields[c.config.PartitionField]) == partition
+}
+
+func (c *collection) page(hits []scored, matched int, base model.SearchBase) model.SearchResult {
+	offset, limit := 0, defaultSearchLimit
+	if base.Offset != nil && *base.Offset > 0 {
+		offset = *base.Offset
+	}
+	if base.Limit != nil && *base.Limit > 0 {
+		limit = *base.Limit
+	}
+	if offset > len(hits) {
+		offset = len(hits)
+	}
+	end := offset + limit
+	if end > len(hits) {
+		end = len(hits)
+	}
+	result := model.SearchResult{FilterMatchedCount: matched, Data: []model.SearchItemResult{}}
+	for _, hit := range hits[offset:end] {
+		result.Data = append(result.Data, model.SearchItemResult{
+			ID:       hit.doc.id,
+			Fields:   c.project(hit.doc, base.OutputFields, false),
+			ANNScore: float32(hit.score),
+			Score:    float32(hit.score),
+		})
+	}
+	result.TotalReturnCount = len(result.Data)
+	return result
+}
+
+// project selects the returned fields. Without explicit output fields every field but the primary key and,
+// unless includeVector, the vector field is returned.
+func (c *collection) project(doc *document, outputFields []string, includeVector bool) model.MapStr {
+	out := model.MapStr{}
+	if len(outputFields) > 0 {
+		for _, field := range outputFields {
+			if value, ok := doc.fields[field]; ok {
+				out[field] = value
+			}
+		}
+		return out
+	}
+	for field, value := range doc.fields {
+		if field == c.config.PrimaryKey || (field == c.config.VectorField && !includeVector) {
+			continue
+		}
+		out[field] = value
+	}
+	return out
+}
+
+func idSet(ids []interface{}) map[string]bool {
+	if len(ids) == 0 {
+		return nil
+	}
+	set := make(map[string]bool, len(ids))
+	for _, id := range ids {
+		set[idKey(id)] = true
+	}
+	return set
+}
+
+func (s *Server) handleDescribeCollection(body []byte) (interface{}, *model.Error) {
+	var req model.DescribeCollectionRequest
+	if err := decode(body, &req); err != nil {
+		return nil, err
+	}
+	c, err := locate(s, req.CollectionLocator)
+	if err != nil {
+		return nil, err
+	}
+	return model.Collection{
+		CollectionName: req.CollectionName,
+		ProjectName:    req.ProjectName,
+		ResourceID:     req.ResourceID,
+		Fields: []model.FieldSchema{
+			{FieldName: c.config.PrimaryKey, IsPrimaryKey: true},
+			{FieldName: c.config.VectorField, FieldType: model.FieldTypeVector},
+		},
+	}, nil
+}
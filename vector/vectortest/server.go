@@ -0,0 +1,277 @@
+// Copyright (c) 2025 Beijing Volcano Engine Technology Co., Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package vectortest provides an in-process fake of the VikingDB data plane for offline tests.
+//
+// The fake implements the /api/vikingdb/data/* endpoints against in-memory collections: writes, fetches,
+// brute-force vector search, scalar, random, id and keyword search, count aggregation and evaluation of the
+// filter expressions built by the filter package. Of the control plane only collection/get is served, and
+// it reports just the configured primary key and vector field. Indexes are not modelled; every index of a
+// collection sees all of its documents. FaultTransport complements it by injecting failures into any HTTP
+// traffic.
+//
+//	srv := vectortest.NewServer()
+//	defer srv.Close()
+//	client, _ := vector.New(vector.AuthAPIKey("test"), vector.WithEndpoint(srv.URL))
+package vectortest
+
+import (
+	"fmt"
+	"io/ioutil"
+	"math/rand"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+
+	"github.com/volcengine/vikingdb-go-sdk/vector"
+	"github.com/volcengine/vikingdb-go-sdk/vector/model"
+	"github.com/volcengine/vikingdb-go-sdk/vector/utils"
+)
+
+// Metric is the similarity used by vector search.
+type Metric string
+
+const (
+	MetricCosine Metric = "cosine"
+	MetricIP     Metric = "ip"
+)
+
+const (
+	defaultPrimaryKey  = "id"
+	defaultVectorField = "vector"
+	defaultSearchLimit = 10
+	successCode        = "Success"
+	requestIDHeader    = "X-Tt-Logid"
+)
+
+// CollectionConfig describes the schema details the fake needs to know about a collection.
+type CollectionConfig struct {
+	// PrimaryKey names the primary key field (default "id").
+	PrimaryKey string
+	// VectorField names the dense vector field (default "vector").
+	VectorField string
+	// Metric is the vector similarity (default MetricCosine).
+	Metric Metric
+	// PartitionField, when set, is matched against the partition of search requests.
+	PartitionField string
+}
+
+func (c CollectionConfig) withDefaults() CollectionConfig {
+	if c.PrimaryKey == "" {
+		c.PrimaryKey = defaultPrimaryKey
+	}
+	if c.VectorField == "" {
+		c.VectorField = defaultVectorField
+	}
+	if c.Metric == "" {
+		c.Metric = MetricCosine
+	}
+	return c
+}
+
+// Option configures a Server.
+type Option func(*Server)
+
+// WithCollection registers the configuration of a collection. Collections that are not registered are
+// created on first use with the default configuration.
+func WithCollection(name string, config CollectionConfig) Option {
+	return func(s *Server) {
+		s.configs[name] = config.withDefaults()
+	}
+}
+
+// WithDefaultCollectionConfig changes the configuration of collections created on first use.
+func WithDefaultCollectionConfig(config CollectionConfig) Option {
+	return func(s *Server) {
+		s.defaults = config.withDefaults()
+	}
+}
+
+// WithSeed seeds the random search so results are reproducible (default 1).
+func WithSeed(seed int64) Option {
+	return func(s *Server) {
+		s.rand = rand.New(rand.NewSource(seed))
+	}
+}
+
+// Server is a fake VikingDB data plane served over HTTP. It is safe for concurrent use.
+type Server struct {
+	*httptest.Server
+
+	mu          sync.Mutex
+	defaults    CollectionConfig
+	configs     map[string]CollectionConfig
+	collections map[string]*collection
+	rand        *rand.Rand
+	requests    int
+}
+
+// NewServer starts a fake server. Callers must Close it.
+func NewServer(opts ...Option) *Server {
+	s := &Server{
+		defaults:    CollectionConfig{}.withDefaults(),
+		configs:     make(map[string]CollectionConfig),
+		collections: make(map[string]*collection),
+		rand:        rand.New(rand.NewSource(1)),
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	s.Server = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+	return s
+}
+
+// NewClient returns a client pointed at the server, authenticated with a dummy API key.
+func (s *Server) NewClient(opts ...vector.ClientOption) (*vector.Client, error) {
+	opts = append([]vector.ClientOption{vector.WithEndpoint(s.URL)}, opts...)
+	return vector.New(vector.AuthAPIKey("vectortest"), opts...)
+}
+
+// Insert stores documents directly, bypassing HTTP. Documents must carry the primary key.
+func (s *Server) Insert(collectionName string, docs ...model.MapStr) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if err := s.collection(collectionName).upsert(docs); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Documents returns a copy of the documents of a collection in insertion order.
+func (s *Server) Documents(collectionName string) []model.MapStr {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	c, ok := s.collections[collectionName]
+	if !ok {
+		return nil
+	}
+	out := make([]model.MapStr, 0, len(c.order))
+	for _, doc := range c.documents() {
+		out = append(out, copyFields(doc.fields))
+	}
+	return out
+}
+
+// Reset drops every stored document.
+func (s *Server) Reset() {
+	s.mu.Lock()
+	s.collections = make(map[string]*collection)
+	s.mu.Unlock()
+}
+
+func (s *Server) collection(name string) *collection {
+	c, ok := s.collections[name]
+	if !ok {
+		config, registered := s.configs[name]
+		if !registered {
+			config = s.defaults
+		}
+		c = newCollection(config)
+		s.collections[name] = c
+	}
+	return c
+}
+
+type handler func(s *Server, body []byte) (interface{}, *model.Error)
+
+var routes = map[string]handler{
+	"/api/vikingdb/data/upsert":              (*Server).handleUpsert,
+	"/api/vikingdb/data/update":              (*Server).handleUpdate,
+	"/api/vikingdb/data/delete":              (*Server).handleDelete,
+	"/api/vikingdb/data/fetch_in_collection": (*Server).handleFetchInCollection,
+	"/api/vikingdb/data/fetch_in_index":      (*Server).handleFetchInIndex,
+	"/api/vikingdb/data/search/vector":       (*Server).handleSearchByVector,
+	"/api/vikingdb/data/search/id":           (*Server).handleSearchByID,
+	"/api/vikingdb/data/search/scalar":       (*Server).handleSearchByScalar,
+	"/api/vikingdb/data/search/random":       (*Server).handleSearchByRandom,
+	"/api/vikingdb/data/search/keywords":     (*Server).handleSearchByKeywords,
+	"/api/vikingdb/data/agg":                 (*Server).handleAgg,
+	describeCollectionPath:                   (*Server).handleDescribeCollection,
+}
+
+// describeCollectionPath is the only control-plane route. Its payload sits beside the envelope fields
+// under "collection" rather than under "result".
+const describeCollectionPath = "/api/vikingdb/collection/get"
+
+func (s *Server) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	s.requests++
+	requestID := fmt.Sprintf("vectortest-%d", s.requests)
+	s.mu.Unlock()
+	w.Header().Set(requestIDHeader, requestID)
+
+	route, ok := routes[r.URL.Path]
+	if !ok {
+		writeError(w, requestID, model.NewErrorWithStatusCode(model.ErrCodeNotFound, "vectortest: unsupported path "+r.URL.Path, http.StatusNotFound))
+		return
+	}
+	if r.Method != http.MethodPost {
+		writeError(w, requestID, model.NewErrorWithStatusCode(model.ErrCodeInvalidParameter, "vectortest: method not allowed", http.StatusMethodNotAllowed))
+		return
+	}
+	body, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		writeError(w, requestID, model.NewErrorWithStatusCode(model.ErrCodeInvalidParameter, "vectortest: failed to read body", http.StatusBadRequest))
+		return
+	}
+
+	s.mu.Lock()
+	result, sdkErr := route(s, body)
+	s.mu.Unlock()
+	if sdkErr != nil {
+		writeError(w, requestID, sdkErr)
+		return
+	}
+	payload := map[string]interface{}{
+		"api":        r.URL.Path,
+		"code":       successCode,
+		"request_id": requestID,
+	}
+	if r.URL.Path == describeCollectionPath {
+		payload["collection"] = result
+	} else {
+		payload["result"] = result
+	}
+	writeJSON(w, http.StatusOK, payload)
+}
+
+func writeError(w http.ResponseWriter, requestID string, sdkErr *model.Error) {
+	writeJSON(w, sdkErr.StatusCode, map[string]interface{}{
+		"code":       sdkErr.Code,
+		"message":    sdkErr.Message,
+		"request_id": requestID,
+	})
+}
+
+func writeJSON(w http.ResponseWriter, status int, payload interface{}) {
+	body, err := utils.SerializeToJSON(payload)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_, _ = w.Write(body)
+}
+
+func decode(body []byte, target interface{}) *model.Error {
+	if err := utils.ParseJSONUseNumber(body, target); err != nil {
+		return invalidParameter("malformed request body: %v", err)
+	}
+	return nil
+}
+
+func invalidParameter(format string, args ...interface{}) *model.Error {
+	return model.NewErrorWithStatusCode(model.ErrCodeInvalidParameter, fmt.Sprintf(format, args...), http.StatusBadRequest)
+}
+
+func locate(s *Server, locator model.CollectionLocator) (*collection, *model.Error) {
+	name := locator.CollectionName
+	if name == "" {
+		name = locator.ResourceID
+	}
+	if name == "" {
+		return nil, invalidParameter("collection_name or resource_id is required")
+	}
+	return s.collection(name), nil
+}
@@ -0,0 +1,153 @@
+// Copyright (c) 2025 Beijing Volcano Engine Technology Co., Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package vectortest_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/volcengine/vikingdb-go-sdk/vector"
+	"github.com/volcengine/vikingdb-go-sdk/vector/filter"
+	"github.com/volcengine/vikingdb-go-sdk/vector/model"
+	"github.com/volcengine/vikingdb-go-sdk/vector/vectortest"
+)
+
+var testIndex = model.IndexLocator{
+	CollectionLocator: model.CollectionLocator{CollectionName: "docs"},
+	IndexName:         "idx",
+}
+
+func newTestIndex(t *testing.T) vector.IndexClient {
+	t.Helper()
+	srv := vectortest.NewServer(vectortest.WithCollection("docs", vectortest.CollectionConfig{PartitionField: "tenant"}))
+	t.Cleanup(srv.Close)
+	docs := []model.MapStr{
+		{"id": "a", "vector": []float64{1, 0}, "color": "red", "price": 10, "title": "apple pie", "tenant": "t1", "tags": []string{"sweet", "baked"}, "loc": "116.40,39.90"},
+		{"id": "b", "vector": []float64{0.9, 0.1}, "color": "green", "price": 20, "title": "apple juice", "tenant": "t1", "tags": []string{"drink"}, "loc": "121.47,31.23"},
+		{"id": "c", "vector": []float64{0, 1}, "color": "red", "price": 30, "title": "cherry tart", "tenant": "t2", "tags": []string{"sweet"}, "loc": "116.41,39.91"},
+		{"id": "d", "vector": []float64{-1, 0}, "color": "blue", "price": 40, "title": "blueberry", "tenant": "t2"},
+	}
+	if err := srv.Insert("docs", docs...); err != nil {
+		t.Fatal(err)
+	}
+	client, err := srv.NewClient()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return client.Index(testIndex)
+}
+
+func hitIDs(resp *model.SearchResponse) []string {
+	ids := []string{}
+	for _, item := range resp.Result.Data {
+		ids = append(ids, fmt.Sprint(item.ID))
+	}
+	return ids
+}
+
+func intPtr(v int) *int { return &v }
+
+func TestSearchByVector(t *testing.T) {
+	index := newTestIndex(t)
+	cases := []struct {
+		name     string
+		base     model.SearchBase
+		query    []float64
+		wantIDs  []string
+		wantCode model.ErrorCode
+	}{
+		{name: "ranked by cosine similarity", query: []float64{1, 0}, wantIDs: []string{"a", "b", "c", "d"}},
+		{name: "limit and offset", query: []float64{1, 0}, base: model.SearchBase{Limit: intPtr(2), Offset: intPtr(1)}, wantIDs: []string{"b", "c"}},
+		{name: "offset past the end", query: []float64{1, 0}, base: model.SearchBase{Offset: intPtr(10)}, wantIDs: []string{}},
+		{name: "filtered", query: []float64{1, 0}, base: model.SearchBase{RecallBase: model.RecallBase{Filter: filter.Must("color", "red").MustBuild()}}, wantIDs: []string{"a", "c"}},
+		{name: "partition", query: []float64{1, 0}, base: model.SearchBase{RecallBase: model.RecallBase{Partition: "t2"}}, wantIDs: []string{"c", "d"}},
+		{name: "ids in and not in", query: []float64{1, 0}, base: model.SearchBase{Advance: &model.SearchAdvance{IDsIn: []interface{}{"a", "b", "d"}, IDsNotIn: []interface{}{"b"}}}, wantIDs: []string{"a", "d"}},
+		{name: "dimension mismatch", query: []float64{1, 0, 0}, wantCode: model.ErrCodeInvalidParameter},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			resp, err := index.SearchByVector(context.Background(), model.SearchByVectorRequest{SearchBase: tc.base, DenseVector: tc.query})
+			checkSearch(t, resp, err, tc.wantIDs, tc.wantCode)
+		})
+	}
+}
+
+func TestFilterOperators(t *testing.T) {
+	index := newTestIndex(t)
+	cases := []struct {
+		name     string
+		filter   filter.Filter
+		wantIDs  []string
+		wantCode model.ErrorCode
+	}{
+		{name: "must", filter: filter.Must("color", "red", "blue"), wantIDs: []string{"a", "c", "d"}},
+		{name: "must on list values", filter: filter.Must("tags", "sweet"), wantIDs: []string{"a", "c"}},
+		{name: "must not", filter: filter.MustNot("color", "red"), wantIDs: []string{"b", "d"}},
+		{name: "range", filter: filter.Range("price", filter.Gt(10), filter.Lte(30)), wantIDs: []string{"b", "c"}},
+		{name: "range out", filter: filter.RangeOut("price", filter.Gte(20), filter.Lt(40)), wantIDs: []string{"a", "d"}},
+		{name: "prefix", filter: filter.Prefix("title", "apple"), wantIDs: []string{"a", "b"}},
+		{name: "contains", filter: filter.Contains("title", "berry"), wantIDs: []string{"d"}},
+		{name: "geo range", filter: filter.GeoRange("loc", 116.40, 39.90, 5000), wantIDs: []string{"a", "c"}},
+		{name: "and", filter: filter.And(filter.Must("color", "red"), filter.Range("price", filter.Gt(15))), wantIDs: []string{"c"}},
+		{name: "or", filter: filter.Or(filter.Must("color", "green"), filter.Prefix("title", "blue")), wantIDs: []string{"b", "d"}},
+		{name: "not", filter: filter.Not(filter.Must("tenant", "t1")), wantIDs: []string{"c", "d"}},
+		{name: "unsupported op", filter: filter.Raw(model.MapStr{"op": "fuzzy", "field": "title"}), wantCode: model.ErrCodeInvalidParameter},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			expr, err := tc.filter.Build()
+			if err != nil {
+				t.Fatal(err)
+			}
+			order := "id"
+			resp, err := index.SearchByScalar(context.Background(), model.SearchByScalarRequest{
+				SearchBase: model.SearchBase{RecallBase: model.RecallBase{Filter: expr}},
+				Field:      &order,
+				Order:      model.ScalarOrderAsc,
+			})
+			checkSearch(t, resp, err, tc.wantIDs, tc.wantCode)
+		})
+	}
+}
+
+func TestSearchByScalarAndKeywords(t *testing.T) {
+	index := newTestIndex(t)
+	price := "price"
+
+	resp, err := index.SearchByScalar(context.Background(), model.SearchByScalarRequest{Field: &price})
+	checkSearch(t, resp, err, []string{"d", "c", "b", "a"}, "")
+	if resp.Result.FilterMatchedCount != 4 {
+		t.Fatalf("filter_matched_count = %d, want 4", resp.Result.FilterMatchedCount)
+	}
+	resp, err = index.SearchByScalar(context.Background(), model.SearchByScalarRequest{Field: &price, Order: model.ScalarOrderAsc, SearchBase: model.SearchBase{OutputFields: []string{"price"}}})
+	checkSearch(t, resp, err, []string{"a", "b", "c", "d"}, "")
+	if fields := resp.Result.Data[0].Fields; len(fields) != 1 || fields["price"] == nil {
+		t.Fatalf("output fields not projected: %v", fields)
+	}
+
+	resp, err = index.SearchByKeywords(context.Background(), model.SearchByKeywordsRequest{Keywords: []string{"APPLE"}})
+	checkSearch(t, resp, err, []string{"a", "b"}, "")
+	resp, err = index.SearchByKeywords(context.Background(), model.SearchByKeywordsRequest{Keywords: []string{"APPLE"}, CaseSensitive: true})
+	checkSearch(t, resp, err, []string{}, "")
+}
+
+func checkSearch(t *testing.T, resp *model.SearchResponse, err error, wantIDs []string, wantCode model.ErrorCode) {
+	t.Helper()
+	if wantCode != "" {
+		var sdkErr *model.Error
+		if !errors.As(err, &sdkErr) || sdkErr.Code != wantCode {
+			t.Fatalf("err = %v, want code %s", err, wantCode)
+		}
+		return
+	}
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := hitIDs(resp); !reflect.DeepEqual(got, wantIDs) {
+		t.Fatalf("ids = %v, want %v", got, wantIDs)
+	}
+}